@@ -0,0 +1,36 @@
+package panelmapping
+
+import (
+	_ "embed"
+	"encoding/json"
+)
+
+// PanelMapping links an editor panel title to the query that feeds it.
+type PanelMapping struct {
+	Title         string `json:"title"`
+	ElementType   string `json:"elementType"`
+	QueryName     string `json:"queryName"`
+	Visualization string `json:"visualization"`
+	Screenshot    string `json:"screenshot,omitempty"`
+}
+
+//go:embed panel-name-mapping.json
+var panelNameMapping []byte
+
+// GetPanelMappings returns the embedded mappings, limited to elementType when it is set.
+func GetPanelMappings(elementType string) ([]PanelMapping, error) {
+	var mappings []PanelMapping
+	if err := json.Unmarshal(panelNameMapping, &mappings); err != nil {
+		return nil, err
+	}
+	if elementType == "" {
+		return mappings, nil
+	}
+	filtered := []PanelMapping{}
+	for _, mapping := range mappings {
+		if mapping.ElementType == elementType {
+			filtered = append(filtered, mapping)
+		}
+	}
+	return filtered, nil
+}
@@ -0,0 +1,276 @@
+package command
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"log"
+	"path"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Appkube-awsx/awsx-getelementdetails/specs"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// PanelCoverage reports how a spec panel is reached. A panel is implemented when it is
+// registered as a subcommand or routed in root.go.
+type PanelCoverage struct {
+	Panel       string `json:"panel"`
+	Implemented bool   `json:"implemented"`
+	Registered  bool   `json:"registered"`
+	Routed      bool   `json:"routed"`
+	Dummy       bool   `json:"dummy"`
+}
+
+type ElementCoverage struct {
+	ElementType string          `json:"elementType"`
+	SpecFile    string          `json:"specFile"`
+	Panels      []PanelCoverage `json:"panels"`
+	Summary     map[string]int  `json:"summary"`
+}
+
+// specElementTypes maps spec directories to handler packages and the elementType
+// values root.go accepts for them.
+var specElementTypes = map[string]struct {
+	Package      string
+	ElementTypes []string
+}{
+	"APIGateway": {"ApiGateway", []string{"ApiGateway", "AWS/ApiGateway"}},
+	"EC2":        {"EC2", []string{"EC2", "AWS/EC2"}},
+	"ECS":        {"ECS", []string{"ECS", "AWS/ECS"}},
+	"EKS":        {"EKS", []string{"EKS", "AWS/EKS"}},
+	"Lambda":     {"Lambda", []string{"Lambda", "AWS/Lambda"}},
+	"NLB":        {"NLB", []string{"AWS/NetworkELB", "AWS/NLB"}},
+	"RDS":        {"RDS", []string{"RDS", "AWS/RDS"}},
+}
+
+var tocEntryRegex = regexp.MustCompile(`^(\s+)- \[([^\]]+)\]\(#`)
+
+// coverageSources records the root.go routes and the dummy handler files, which
+// cannot be read from the binary. Regenerate it with go generate after changing
+// root.go or a handler package.
+//
+//go:generate go run ./coveragegen -source .. -out coverage-sources.json
+//go:embed coverage-sources.json
+var coverageSources []byte
+
+type coverageRoute struct {
+	Query        string   `json:"query"`
+	ElementTypes []string `json:"elementTypes"`
+	Dummy        bool     `json:"dummy"`
+}
+
+func (r coverageRoute) matchesElementType(elementTypes []string) bool {
+	for _, routed := range r.ElementTypes {
+		for _, elementType := range elementTypes {
+			if routed == elementType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type handlerSources struct {
+	Routes   []coverageRoute `json:"routes"`
+	Packages map[string]struct {
+		DummyFiles []string `json:"dummyFiles"`
+	} `json:"packages"`
+}
+
+var AwsxCoverageCmd = &cobra.Command{
+	Use:   "coverage",
+	Short: "report which spec panels are implemented",
+	Long:  `command to compare the panels listed in specs/*.md with the implemented handlers`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		responseType, _ := cmd.PersistentFlags().GetString("responseType")
+
+		jsonResp, coverage, err := GetSpecCoverage(cmd.Root())
+		if err != nil {
+			log.Println("Error getting spec coverage: ", err)
+			return
+		}
+		if responseType == "frame" {
+			fmt.Println(printCoverageTable(coverage))
+		} else {
+			fmt.Println(jsonResp)
+		}
+	},
+}
+
+// GetSpecCoverage reads the panel lists from the embedded spec tables of contents and
+// checks each panel against the handler commands registered under root and the
+// recorded root.go routes.
+func GetSpecCoverage(root *cobra.Command) (string, []ElementCoverage, error) {
+	specFiles, err := fs.Glob(specs.Files, "*/*.md")
+	if err != nil {
+		return "", nil, err
+	}
+	sort.Strings(specFiles)
+
+	var sources handlerSources
+	if err := json.Unmarshal(coverageSources, &sources); err != nil {
+		return "", nil, err
+	}
+
+	// registered maps handler packages to their registered command uses and the
+	// file each command is declared in
+	registered := map[string]map[string]string{}
+	var walk func(cmd *cobra.Command)
+	walk = func(cmd *cobra.Command) {
+		if pkg, file := commandSource(cmd); pkg != "" {
+			if registered[pkg] == nil {
+				registered[pkg] = map[string]string{}
+			}
+			registered[pkg][cmd.Use] = file
+		}
+		for _, child := range cmd.Commands() {
+			walk(child)
+		}
+	}
+	walk(root)
+
+	var coverage []ElementCoverage
+	for _, specFile := range specFiles {
+		specDir := path.Dir(specFile)
+		element, ok := specElementTypes[specDir]
+		if !ok {
+			log.Printf("Skipping spec %s: unknown element type", specFile)
+			continue
+		}
+
+		panels, err := parseSpecPanels(specFile)
+		if err != nil {
+			return "", nil, err
+		}
+		dummyFiles := map[string]bool{}
+		for _, file := range sources.Packages[element.Package].DummyFiles {
+			dummyFiles[file] = true
+		}
+
+		elementCoverage := ElementCoverage{
+			ElementType: specDir,
+			SpecFile:    path.Join("specs", specFile),
+			Summary:     map[string]int{"total": len(panels)},
+		}
+		for _, panel := range panels {
+			file, isRegistered := registered[element.Package][panel]
+			panelCoverage := PanelCoverage{
+				Panel:      panel,
+				Registered: isRegistered,
+				Dummy:      isRegistered && dummyFiles[file],
+			}
+
+			for _, route := range sources.Routes {
+				if route.Query != panel || !route.matchesElementType(element.ElementTypes) {
+					continue
+				}
+				panelCoverage.Routed = true
+				if route.Dummy {
+					panelCoverage.Dummy = true
+				}
+			}
+			panelCoverage.Implemented = panelCoverage.Registered || panelCoverage.Routed
+
+			if panelCoverage.Implemented {
+				elementCoverage.Summary["implemented"]++
+			}
+			if panelCoverage.Registered {
+				elementCoverage.Summary["registered"]++
+			}
+			if panelCoverage.Routed {
+				elementCoverage.Summary["routed"]++
+			}
+			if panelCoverage.Dummy {
+				elementCoverage.Summary["dummy"]++
+			}
+			elementCoverage.Panels = append(elementCoverage.Panels, panelCoverage)
+		}
+		coverage = append(coverage, elementCoverage)
+	}
+
+	jsonString, err := json.Marshal(coverage)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+	return string(jsonString), coverage, nil
+}
+
+// commandSource returns the handler package and file, without extension, that declare
+// the Run function of cmd. Commands outside the handler packages return empty strings.
+func commandSource(cmd *cobra.Command) (string, string) {
+	if cmd.Run == nil {
+		return "", ""
+	}
+	fn := runtime.FuncForPC(reflect.ValueOf(cmd.Run).Pointer())
+	if fn == nil {
+		return "", ""
+	}
+	// Function names look like <module>/handler/EC2.glob..func1
+	name := fn.Name()
+	index := strings.LastIndex(name, "/handler/")
+	if index < 0 {
+		return "", ""
+	}
+	pkg := name[index+len("/handler/"):]
+	pkg = pkg[:strings.Index(pkg, ".")]
+	file, _ := fn.FileLine(fn.Entry())
+	return pkg, strings.TrimSuffix(filepath.Base(file), ".go")
+}
+
+// parseSpecPanels returns the nested entries of the table of contents, which are the panels.
+func parseSpecPanels(specFile string) ([]string, error) {
+	content, err := fs.ReadFile(specs.Files, specFile)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	var panels []string
+	for _, line := range strings.Split(string(content), "\n") {
+		match := tocEntryRegex.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		panel := strings.TrimSpace(strings.ReplaceAll(match[2], `\_`, "_"))
+		if !seen[panel] {
+			seen[panel] = true
+			panels = append(panels, panel)
+		}
+	}
+	return panels, nil
+}
+
+func printCoverageTable(coverage []ElementCoverage) string {
+	var buffer bytes.Buffer
+	table := tablewriter.NewWriter(&buffer)
+	table.SetHeader([]string{"Element Type", "Panel", "Implemented", "Registered", "Routed", "Dummy"})
+	for _, element := range coverage {
+		for _, panel := range element.Panels {
+			table.Append([]string{
+				element.ElementType,
+				panel.Panel,
+				strconv.FormatBool(panel.Implemented),
+				strconv.FormatBool(panel.Registered),
+				strconv.FormatBool(panel.Routed),
+				strconv.FormatBool(panel.Dummy),
+			})
+		}
+	}
+	table.Render()
+	return buffer.String()
+}
+
+func init() {
+	AwsxCoverageCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,219 @@
+// Command coveragegen records the facts the coverage command cannot read from the
+// binary: which queries root.go dispatches and which handler files return dummy data.
+// It is run by go generate in the command package.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Route is a queryName block of the root.go dispatch chain.
+type Route struct {
+	Query        string   `json:"query"`
+	ElementTypes []string `json:"elementTypes"`
+	// Dummy is set when the block calls a handler function from a dummy file
+	Dummy bool `json:"dummy"`
+}
+
+// PackageSources holds the handler files that never reach AWS.
+type PackageSources struct {
+	DummyFiles []string `json:"dummyFiles"`
+}
+
+type Sources struct {
+	Routes   []Route                   `json:"routes"`
+	Packages map[string]PackageSources `json:"packages"`
+}
+
+var (
+	queryRouteRegex  = regexp.MustCompile(`queryName == "([^"]+)"`)
+	elementTypeRegex = regexp.MustCompile(`elementType == "([^"]+)"`)
+	callRegex        = regexp.MustCompile(`\b([A-Z]\w*)\.(\w+)\(`)
+)
+
+type routeCall struct {
+	pkg  string
+	name string
+}
+
+func main() {
+	sourceDir := flag.String("source", "..", "repository root holding command/ and handler/")
+	out := flag.String("out", "coverage-sources.json", "output file")
+	flag.Parse()
+
+	jsonString, err := generateSources(*sourceDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, jsonString, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generateSources reads root.go and the handler packages under sourceDir and returns
+// the sources as the indented JSON written to the output file.
+func generateSources(sourceDir string) ([]byte, error) {
+	rootSource, err := os.ReadFile(filepath.Join(sourceDir, "command", "root.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	packageDirs, err := filepath.Glob(filepath.Join(sourceDir, "handler", "*"))
+	if err != nil {
+		return nil, err
+	}
+	sources := Sources{Packages: map[string]PackageSources{}}
+	// dummyFuncs maps package names to the functions declared in their dummy files
+	dummyFuncs := map[string]map[string]bool{}
+	for _, dir := range packageDirs {
+		info, err := os.Stat(dir)
+		if err != nil || !info.IsDir() {
+			continue
+		}
+		name := filepath.Base(dir)
+		dummyFiles, funcs, err := parseHandlerPackage(dir)
+		if err != nil {
+			return nil, err
+		}
+		sources.Packages[name] = PackageSources{DummyFiles: dummyFiles}
+		dummyFuncs[name] = funcs
+	}
+
+	for _, route := range parseRoutes(string(rootSource)) {
+		for _, call := range route.calls {
+			if dummyFuncs[call.pkg][call.name] {
+				route.Dummy = true
+			}
+		}
+		sources.Routes = append(sources.Routes, route.Route)
+	}
+
+	jsonString, err := json.MarshalIndent(sources, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(jsonString, '\n'), nil
+}
+
+type parsedRoute struct {
+	Route
+	calls []routeCall
+}
+
+// parseRoutes splits the queryName dispatch chain into one block per query and
+// records the element types it accepts and the handler functions it calls. Only
+// elementType comparisons count, so other conditions such as mode are ignored.
+func parseRoutes(rootSource string) []parsedRoute {
+	var routes []parsedRoute
+	var current *parsedRoute
+	for _, line := range strings.Split(rootSource, "\n") {
+		if match := queryRouteRegex.FindStringSubmatch(line); match != nil && strings.Contains(line, "if ") {
+			if current != nil {
+				routes = append(routes, *current)
+			}
+			current = &parsedRoute{Route: Route{Query: match[1]}}
+			condition := line[strings.Index(line, match[0])+len(match[0]):]
+			for _, elementType := range elementTypeRegex.FindAllStringSubmatch(condition, -1) {
+				current.ElementTypes = append(current.ElementTypes, elementType[1])
+			}
+			continue
+		}
+		if current == nil {
+			continue
+		}
+		if strings.HasPrefix(strings.TrimSpace(line), "//") {
+			continue
+		}
+		for _, call := range callRegex.FindAllStringSubmatch(line, -1) {
+			current.calls = append(current.calls, routeCall{pkg: call[1], name: call[2]})
+		}
+	}
+	if current != nil {
+		routes = append(routes, *current)
+	}
+	return routes
+}
+
+// parseHandlerPackage returns the panel files of a handler package that never reach AWS,
+// without extension, and the functions they declare.
+func parseHandlerPackage(dir string) ([]string, map[string]bool, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dummyFiles := []string{}
+	funcs := map[string]bool{}
+	fset := token.NewFileSet()
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			log.Printf("Error parsing %s: %v", path, err)
+			continue
+		}
+		if !declaresCommand(file) || callsAws(file) {
+			continue
+		}
+		dummyFiles = append(dummyFiles, strings.TrimSuffix(filepath.Base(path), ".go"))
+		for _, decl := range file.Decls {
+			if d, ok := decl.(*ast.FuncDecl); ok && d.Recv == nil {
+				funcs[d.Name.Name] = true
+			}
+		}
+	}
+	sort.Strings(dummyFiles)
+	return dummyFiles, funcs, nil
+}
+
+// declaresCommand reports whether the file declares a cobra command, which sets panel
+// files apart from the shared helpers of a package.
+func declaresCommand(file *ast.File) bool {
+	for _, decl := range file.Decls {
+		d, ok := decl.(*ast.GenDecl)
+		if !ok || d.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range d.Specs {
+			for _, value := range spec.(*ast.ValueSpec).Values {
+				unary, ok := value.(*ast.UnaryExpr)
+				if !ok {
+					continue
+				}
+				literal, ok := unary.X.(*ast.CompositeLit)
+				if !ok {
+					continue
+				}
+				if selector, ok := literal.Type.(*ast.SelectorExpr); ok && selector.Sel.Name == "Command" {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// callsAws reports whether the file reaches AWS, either through an SDK service
+// package or through another handler package.
+func callsAws(file *ast.File) bool {
+	for _, imp := range file.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		if strings.Contains(path, "aws-sdk-go/service/") || strings.Contains(path, "awsx-getelementdetails/handler/") {
+			return true
+		}
+	}
+	return false
+}
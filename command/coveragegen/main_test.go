@@ -0,0 +1,49 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestCoverageSourcesUpToDate fails when the embedded coverage sources no longer match
+// root.go and the handler packages.
+func TestCoverageSourcesUpToDate(t *testing.T) {
+	generated, err := generateSources(filepath.Join("..", ".."))
+	if err != nil {
+		t.Fatal(err)
+	}
+	embedded, err := os.ReadFile(filepath.Join("..", "coverage-sources.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(generated, embedded) {
+		t.Fatal("command/coverage-sources.json is stale, run go generate ./command/")
+	}
+}
+
+func TestParseRoutesElementTypes(t *testing.T) {
+	rootSource := `
+		} else if queryName == "security_group_configuration_panel" && (elementType == "AWS/NetworkELB" || elementType == "AWS/NLB") && mode == "analysis" {
+			jsonResp, _, err := NLB.GetSecurityGroupAnalysis(cmd, clientAuth)
+		}
+`
+	routes := parseRoutes(rootSource)
+	if len(routes) != 1 {
+		t.Fatalf("got %d routes, want 1", len(routes))
+	}
+	if len(routes[0].calls) != 1 || routes[0].calls[0] != (routeCall{pkg: "NLB", name: "GetSecurityGroupAnalysis"}) {
+		t.Fatalf("got calls %v, want NLB.GetSecurityGroupAnalysis", routes[0].calls)
+	}
+	want := []string{"AWS/NetworkELB", "AWS/NLB"}
+	got := routes[0].ElementTypes
+	if len(got) != len(want) {
+		t.Fatalf("got element types %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got element types %v, want %v", got, want)
+		}
+	}
+}
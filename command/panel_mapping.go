@@ -0,0 +1,99 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	panelmapping "github.com/Appkube-awsx/awsx-getelementdetails/Appkube-editor-panel-name-mapping"
+	"github.com/spf13/cobra"
+)
+
+var AwsxPanelMappingCmd = &cobra.Command{
+	Use:   "panel_name_mapping",
+	Short: "get editor panel name to query name mapping",
+	Long:  `command to get the mapping between editor panel titles, element types, query names and visualization types`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		elementType, _ := cmd.PersistentFlags().GetString("elementType")
+
+		jsonResp, _, err := GetPanelNameMapping(elementType)
+		if err != nil {
+			log.Println("Error getting panel name mapping: ", err)
+			return
+		}
+		fmt.Println(jsonResp)
+	},
+}
+
+// mappingElementTypes maps every elementType root.go accepts to the element type used in
+// the panel name mapping. The AWS/ namespace forms do not always share a name with it.
+var mappingElementTypes = map[string]string{
+	"ALB":                "ALB",
+	"AWS/ApplicationELB": "ALB",
+	"ApiGateway":         "ApiGateway",
+	"AWS/ApiGateway":     "ApiGateway",
+	"AuroraCluster":      "AuroraCluster",
+	"CloudFront":         "CloudFront",
+	"AWS/CloudFront":     "CloudFront",
+	"DynamoDB":           "DynamoDB",
+	"AWS/DynamoDB":       "DynamoDB",
+	"EC2":                "EC2",
+	"AWS/EC2":            "EC2",
+	"ECS":                "ECS",
+	"AWS/ECS":            "ECS",
+	"EFS":                "EFS",
+	"AWS/EFS":            "EFS",
+	"EKS":                "EKS",
+	"AWS/EKS":            "EKS",
+	"ElastiCache":        "ElastiCache",
+	"AWS/ElastiCache":    "ElastiCache",
+	"Kinesis":            "Kinesis",
+	"AWS/Kinesis":        "Kinesis",
+	"Lambda":             "Lambda",
+	"MSK":                "MSK",
+	"AWS/Kafka":          "MSK",
+	"NATGateway":         "NATGateway",
+	"AWS/NATGateway":     "NATGateway",
+	"AWS/NetworkELB":     "NLB",
+	"AWS/NLB":            "NLB",
+	"OpenSearch":         "OpenSearch",
+	"AWS/ES":             "OpenSearch",
+	"RDS":                "RDS",
+	"AWS/RDS":            "RDS",
+	"Route53":            "Route53",
+	"AWS/Route53":        "Route53",
+	"S3":                 "S3",
+	"AWS/S3":             "S3",
+	"SNS":                "SNS",
+	"AWS/SNS":            "SNS",
+	"SQS":                "SQS",
+	"AWS/SQS":            "SQS",
+	"StepFunctions":      "StepFunctions",
+	"AWS/States":         "StepFunctions",
+}
+
+func GetPanelNameMapping(elementType string) (string, []panelmapping.PanelMapping, error) {
+	mappingElementType := elementType
+	if elementType != "" {
+		var ok bool
+		mappingElementType, ok = mappingElementTypes[elementType]
+		if !ok {
+			return "", nil, fmt.Errorf("unknown element type %s", elementType)
+		}
+	}
+	mappings, err := panelmapping.GetPanelMappings(mappingElementType)
+	if err != nil {
+		return "", nil, err
+	}
+	jsonString, err := json.Marshal(mappings)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+	return string(jsonString), mappings, nil
+}
+
+func init() {
+	AwsxPanelMappingCmd.PersistentFlags().String("elementType", "", "element type. returns every mapping when empty")
+}
@@ -5,13 +5,27 @@ import (
 	"log"
 
 	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/ALB"
 	"github.com/Appkube-awsx/awsx-getelementdetails/handler/ApiGateway"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/CloudFront"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/DynamoDB"
 	"github.com/Appkube-awsx/awsx-getelementdetails/handler/EC2"
 	"github.com/Appkube-awsx/awsx-getelementdetails/handler/ECS"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/EFS"
 	"github.com/Appkube-awsx/awsx-getelementdetails/handler/EKS"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/ElastiCache"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Kinesis"
 	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Lambda"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/MSK"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/NATGateway"
 	"github.com/Appkube-awsx/awsx-getelementdetails/handler/NLB"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/OpenSearch"
 	"github.com/Appkube-awsx/awsx-getelementdetails/handler/RDS"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Route53"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/S3"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/SNS"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/SQS"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/StepFunctions"
 	"github.com/spf13/cobra"
 )
 
@@ -36,6 +50,7 @@ var AwsxCloudWatchMetricsCmd = &cobra.Command{
 			elementType, _ := cmd.PersistentFlags().GetString("elementType")
 			// cloudWatchQuery, _ := cmd.PersistentFlags().GetString("cloudWatchQuery")
 			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			mode, _ := cmd.PersistentFlags().GetString("mode")
 
 			if queryName == "cpu_utilization_panel" && (elementType == "EC2" || elementType == "AWS/EC2") {
 				jsonResp, cloudwatchMetricResp, err := EC2.GetCpuUtilizationPanel(cmd, clientAuth, nil)
@@ -456,7 +471,7 @@ var AwsxCloudWatchMetricsCmd = &cobra.Command{
 				// 	// 	fmt.Println(cloudwatchMetric)
 
 			} else if queryName == "alert_and_notification_panel" && (elementType == "EC2" || elementType == "AWS/EC2") {
-				jsonResp, err := EC2.GetAlertsAndNotificationsPanel(cmd, clientAuth)
+				jsonResp, _, err := EC2.GetAlertsAndNotificationsPanel(cmd, clientAuth)
 				if err != nil {
 					log.Println("Error getting network inbound metric data: ", err)
 					return
@@ -465,6 +480,83 @@ var AwsxCloudWatchMetricsCmd = &cobra.Command{
 				// 	fmt.Println(cloudwatchMetricResp)
 				// } else {
 				fmt.Println(jsonResp)
+			} else if queryName == "mean_time_to_recovery_panel" && (elementType == "EC2" || elementType == "AWS/EC2") {
+				jsonResp, recoveryStats, err := EC2.GetEC2MeanTimeToRecoveryPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting mean time to recovery: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(recoveryStats)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "ebs_volume_iops_panel" && (elementType == "EC2" || elementType == "AWS/EC2") {
+				jsonResp, cloudwatchMetricResp, err := EC2.GetEBSVolumeIOPSPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting ebs volume iops: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "ebs_volume_throughput_panel" && (elementType == "EC2" || elementType == "AWS/EC2") {
+				jsonResp, cloudwatchMetricResp, err := EC2.GetEBSVolumeThroughputPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting ebs volume throughput: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "ebs_volume_queue_length_panel" && (elementType == "EC2" || elementType == "AWS/EC2") {
+				jsonResp, cloudwatchMetricResp, err := EC2.GetEBSVolumeQueueLengthPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting ebs volume queue length: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "ebs_burst_balance_panel" && (elementType == "EC2" || elementType == "AWS/EC2") {
+				jsonResp, cloudwatchMetricResp, err := EC2.GetEBSBurstBalancePanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting ebs burst balance: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "ebs_volume_idle_time_panel" && (elementType == "EC2" || elementType == "AWS/EC2") {
+				jsonResp, cloudwatchMetricResp, err := EC2.GetEBSVolumeIdleTimePanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting ebs volume idle time: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "ebs_volume_latency_panel" && (elementType == "EC2" || elementType == "AWS/EC2") {
+				jsonResp, cloudwatchMetricResp, err := EC2.GetEBSVolumeLatencyPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting ebs volume latency: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
 			} else if queryName == "storage_utilization_panel" && (elementType == "EC2" || elementType == "AWS/EC2") {
 				jsonResp, cloudwatchMetricResp, err := EC2.GetStorageUtilizationPanel(cmd, clientAuth, nil)
 				if err != nil {
@@ -608,6 +700,17 @@ var AwsxCloudWatchMetricsCmd = &cobra.Command{
 				} else {
 					fmt.Println(jsonResp)
 				}
+			} else if queryName == "mean_time_to_recovery_panel" && (elementType == "EKS" || elementType == "AWS/EKS") {
+				jsonResp, recoveryStats, err := EKS.GetEKSMeanTimeToRecoveryPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting mean time to recovery: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(recoveryStats)
+				} else {
+					fmt.Println(jsonResp)
+				}
 			} else if queryName == "node_failure_panel" && elementType == "EKS" {
 				jsonResp, cloudwatchMetricResp, err := EKS.GetNodeFailureData(cmd, clientAuth, nil)
 				if err != nil {
@@ -1103,6 +1206,17 @@ var AwsxCloudWatchMetricsCmd = &cobra.Command{
 				} else {
 					fmt.Println(jsonResp)
 				}
+			} else if queryName == "mean_time_to_recovery_panel" && (elementType == "AWS/ECS" || elementType == "ECS") {
+				jsonResp, recoveryStats, err := ECS.GetECSMeanTimeToRecoveryPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting mean time to recovery: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(recoveryStats)
+				} else {
+					fmt.Println(jsonResp)
+				}
 			} else if queryName == "service_error_panel" && (elementType == "ECS" || elementType == "AWS/ECS") {
 				events, err := ECS.ListServiceErrors()
 				if err != nil {
@@ -1213,7 +1327,7 @@ var AwsxCloudWatchMetricsCmd = &cobra.Command{
 				}
 			} else if queryName == "functions_by_region_panel" && elementType == "Lambda" {
 				log.Printf("ClientAuth: %+v\n", clientAuth)
-				jsonResp, cloudwatchMetricResp, err := Lambda.GetLambdaFunctionsByRegion(clientAuth)
+				jsonResp, cloudwatchMetricResp, err := Lambda.GetLambdaFunctionsByRegion(cmd, clientAuth)
 				if err != nil {
 					log.Println("Error getting functions by regions data: ", err)
 					return
@@ -1891,7 +2005,7 @@ var AwsxCloudWatchMetricsCmd = &cobra.Command{
 					fmt.Println(jsonResp)
 				}
 			} else if queryName == "alert_and_notification_panel" && (elementType == "RDS" || elementType == "AWS/RDS") {
-				jsonResp, err := RDS.GetAlertsAndNotificationsPanell(cmd, clientAuth)
+				jsonResp, _, err := RDS.GetAlertsAndNotificationsPanell(cmd, clientAuth)
 				if err != nil {
 					log.Println("Error getting alert and notification data: ", err)
 					return
@@ -1900,6 +2014,17 @@ var AwsxCloudWatchMetricsCmd = &cobra.Command{
 				// 	fmt.Println(cloudwatchMetricResp)
 				// } else {
 				fmt.Println(jsonResp)
+			} else if queryName == "mean_time_to_recovery_panel" && (elementType == "RDS" || elementType == "AWS/RDS") {
+				jsonResp, recoveryStats, err := RDS.GetRDSMeanTimeToRecoveryPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting mean time to recovery: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(recoveryStats)
+				} else {
+					fmt.Println(jsonResp)
+				}
 			} else if queryName == "iops_panel" && (elementType == "RDS" || elementType == "AWS/RDS") {
 				jsonResp, cloudwatchMetricResp, err, _ := RDS.GetRDSIopsPanel(cmd, clientAuth, nil)
 				if err != nil {
@@ -2155,8 +2280,15 @@ var AwsxCloudWatchMetricsCmd = &cobra.Command{
 				} else {
 					fmt.Println(printresp)
 				}
+			} else if queryName == "security_group_configuration_panel" && (elementType == "AWS/NetworkELB") && mode == "analysis" {
+				jsonResp, _, err := NLB.GetSecurityGroupAnalysis(cmd, clientAuth)
+				if err != nil {
+					log.Println("Error getting security group analysis:", err)
+					return
+				}
+				fmt.Println(jsonResp)
 			} else if queryName == "security_group_configuration_panel" && (elementType == "AWS/NetworkELB") {
-				securityGroup, printresp, err := NLB.GetSecurityGroupConfigurations(clientAuth)
+				securityGroup, printresp, err := NLB.GetSecurityGroupConfigurations(cmd, clientAuth)
 				if err != nil {
 					log.Println("Error getting security group configuration:", err)
 					return
@@ -2287,147 +2419,1327 @@ var AwsxCloudWatchMetricsCmd = &cobra.Command{
 				} else {
 					fmt.Println(jsonResp)
 				}
-			} else {
-				fmt.Println("query not found")
-			}
-		}
-	},
-}
-
-func Execute() {
-	if err := AwsxCloudWatchMetricsCmd.Execute(); err != nil {
-		log.Printf("error executing command: %v\n", err)
-	}
-}
-
-func init() {
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2CustomAlertPanelCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2CpuUtilizationCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2MemoryUtilizationCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2NetworkUtilizationCmd)
-	// AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2StorageUtilizationCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2CpuUsageUserCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2CpuUsageUserCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2CpuUsageIdleCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2CpuSysTimeCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2CpuUsageNiceCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2MemCachedCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2MemoryUsageTotalCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2MemoryUsageUsedCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2MemoryUsageFreeCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2NetworkInBytesCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2NetworkOutBytesCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2NetworkOutPacketsCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2NetworkInPacketsCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2DiskReadCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2DiskWriteCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2DiskUsedCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2hostedServicesCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2CpuUtilizationGraphsCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2MemoryUtilizationGraphCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.ListErrorsCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEC2NetworkTrafficCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2DiskAvailableCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2NetworkInboundCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2NetworkOutboundCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2AlarmandNotificationcmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2InstanceStopCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEC2DiskIOPerformanceCmd)
-	//AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2InstanceStopCmdTest)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2NetworkOutBytesCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2InstanceStatusCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2ErrorRatePanelCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2InstanceHealthCheckCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSAllocatableCpuCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSCpuLimitsCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSCpuRequestsCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSCpuUtilizationCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSCpuUtilizationGraphCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSCpuUtilizationNodeGraphCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSDiskUtilizationCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSMemoryLimitsCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSMemoryRequestsCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSMemoryUsageCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSMemoryUtilizationCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSMemoryUtilizationGraphCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSNetworkAvailabilityCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSNetworkInOutCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSNetworkThroughputCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSNetworkThroughputSingleCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSNetworkUtilizationCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSNodeCapacityCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSNodeStabilityCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSIncidentResponseTimeCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSNodeDowntimeCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSNodeEventLogsCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSNodeUptimeCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSServiceAvailabilityCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSStorageUtilizationCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxECSCpuUtilizationCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxECSCpuUtilizationGraphCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxCpuReservedCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxECSMemoryUtilizationCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxECSMemoryUtilizationGraphCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxMemoryReservedCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxECSStorageUtilizationCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxECSNetworkRxInBytesCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxECSNetworkTxInBytesCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxECSReadBytesCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxECSWriteBytesCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxResourceCreatedPanelCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxResourceDeletedPanelCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxResourceUpdatedPanelCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxEcsServiceErrorCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxECSUptimeCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(Lambda.AwsxLambdaCpuCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(Lambda.AwsxLambdaFailureCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(Lambda.AwsxLambdaSuccessFailureCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(Lambda.AwsxLambdaNumberOfCallsCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSCpuUtilizationCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSNetworkTrafficCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSNetworkUtilizationCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSFreeStorageSpaceCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSFreeableMemoryCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSDiskQueueDepthCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSNetworkTrafficCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSReplicationSlotDiskUsageCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSNetworkReceiveThroughputCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSNetworkTransmitThroughputCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSDBLoadCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSDBLoadNonCPUCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSDBLoadCPUCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSStorageUtilizationCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSLatencyAnalysisCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSCPUCreditUsageCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSCPUSurplusCreditBalanceCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSSurplusCreditsChargedCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSCpuUtilizationGraphCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSDatabaseConnectionsCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSDBLoadCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSIndexSizeCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxDBInstanceHealthCheckCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSIopsCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSReadIOPSCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSTransactionLogsDiskCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSTransactionLogsGenCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSWriteIOPSCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSErrorAnalysisCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSUptimeCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSMemoryUtilizationCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(ApiGateway.ApiResponseTimeCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(ApiGateway.AwsxApiCacheHitsCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(ApiGateway.AwsxApiCacheMissCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(ApiGateway.AwsxApiDowntimeIncidentsCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(ApiGateway.AwsxApiUptimeCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(ApiGateway.AwsxApiDeploymentCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(ApiGateway.AwsxApiCallsCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(NLB.AwsxNLBActiveConnectionsCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(NLB.AwsxNLBNewConnectionsCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(NLB.AwsxNLBProcessedBytesCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(NLB.AwsxNLBHealthyHostCountCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(NLB.AwsxNLBUnhealthyHostCountCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(NLB.AwsxNLBSSLTLSNegotiationCmd)
-	AwsxCloudWatchMetricsCmd.AddCommand(NLB.AwsxNLBTargetHealthChecksCmd)
+			} else if queryName == "request_count_panel" && (elementType == "ALB" || elementType == "AWS/ApplicationELB") {
+				jsonResp, cloudwatchMetricResp, err := ALB.GetALBRequestCountPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting request count data: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "http_code_panel" && (elementType == "ALB" || elementType == "AWS/ApplicationELB") {
+				jsonResp, cloudwatchMetricResp, err := ALB.GetALBHTTPCodePanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting http code data: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "target_response_time_panel" && (elementType == "ALB" || elementType == "AWS/ApplicationELB") {
+				jsonResp, cloudwatchMetricResp, err := ALB.GetALBTargetResponseTimePanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting target response time data: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "rejected_connections_panel" && (elementType == "ALB" || elementType == "AWS/ApplicationELB") {
+				jsonResp, cloudwatchMetricResp, err := ALB.GetALBRejectedConnectionsPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting rejected connections data: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "target_group_host_count_panel" && (elementType == "ALB" || elementType == "AWS/ApplicationELB") {
+				jsonResp, cloudwatchMetricResp, err := ALB.GetALBTargetGroupHostCountPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting target group host count data: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "lcu_consumption_panel" && (elementType == "ALB" || elementType == "AWS/ApplicationELB") {
+				jsonResp, cloudwatchMetricResp, err := ALB.GetALBLCUConsumptionPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting lcu consumption data: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "target_status_panel" && (elementType == "ALB" || elementType == "AWS/ApplicationELB") {
+				jsonResp, table, err := ALB.GetALBTargetStatusPanel(cmd, clientAuth)
+				if err != nil {
+					log.Println("Error getting target status: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(table)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "capacity_utilization_panel" && (elementType == "DynamoDB" || elementType == "AWS/DynamoDB") {
+				jsonResp, cloudwatchMetricResp, err := DynamoDB.GetDynamoDBCapacityUtilizationPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting capacity utilization data: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "throttle_events_panel" && (elementType == "DynamoDB" || elementType == "AWS/DynamoDB") {
+				jsonResp, cloudwatchMetricResp, err := DynamoDB.GetDynamoDBThrottleEventsPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting throttle events data: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "latency_by_operation_panel" && (elementType == "DynamoDB" || elementType == "AWS/DynamoDB") {
+				jsonResp, cloudwatchMetricResp, err := DynamoDB.GetDynamoDBLatencyByOperationPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting latency by operation data: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "errors_panel" && (elementType == "DynamoDB" || elementType == "AWS/DynamoDB") {
+				jsonResp, cloudwatchMetricResp, err := DynamoDB.GetDynamoDBErrorsPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting errors data: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "gsi_capacity_panel" && (elementType == "DynamoDB" || elementType == "AWS/DynamoDB") {
+				jsonResp, cloudwatchMetricResp, err := DynamoDB.GetDynamoDBGSICapacityPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting gsi capacity data: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "capacity_mode_panel" && (elementType == "DynamoDB" || elementType == "AWS/DynamoDB") {
+				jsonResp, table, err := DynamoDB.GetDynamoDBCapacityModePanel(cmd, clientAuth)
+				if err != nil {
+					log.Println("Error getting capacity mode: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(table)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "table_size_panel" && (elementType == "DynamoDB" || elementType == "AWS/DynamoDB") {
+				jsonResp, table, err := DynamoDB.GetDynamoDBTableSizePanel(cmd, clientAuth)
+				if err != nil {
+					log.Println("Error getting table size: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(table)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "messages_panel" && (elementType == "SQS" || elementType == "AWS/SQS") {
+				jsonResp, cloudwatchMetricResp, err := SQS.GetSQSMessagesPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting messages data: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "oldest_message_age_panel" && (elementType == "SQS" || elementType == "AWS/SQS") {
+				jsonResp, cloudwatchMetricResp, err := SQS.GetSQSOldestMessageAgePanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting oldest message age data: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "message_rates_panel" && (elementType == "SQS" || elementType == "AWS/SQS") {
+				jsonResp, cloudwatchMetricResp, err := SQS.GetSQSMessageRatesPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting message rates data: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "empty_receives_panel" && (elementType == "SQS" || elementType == "AWS/SQS") {
+				jsonResp, cloudwatchMetricResp, err := SQS.GetSQSEmptyReceivesPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting empty receives data: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "dead_letter_queue_depth_panel" && (elementType == "SQS" || elementType == "AWS/SQS") {
+				jsonResp, cloudwatchMetricResp, err := SQS.GetSQSDeadLetterQueueDepthPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting dead-letter queue depth data: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "time_to_drain_panel" && (elementType == "SQS" || elementType == "AWS/SQS") {
+				jsonResp, cloudwatchMetricResp, err := SQS.GetSQSTimeToDrainPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting time to drain data: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "storage_panel" && (elementType == "S3" || elementType == "AWS/S3") {
+				jsonResp, cloudwatchMetricResp, err := S3.GetS3StoragePanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting s3 storage: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "request_metrics_panel" && (elementType == "S3" || elementType == "AWS/S3") {
+				jsonResp, cloudwatchMetricResp, err := S3.GetS3RequestMetricsPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting s3 request metrics: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "replication_panel" && (elementType == "S3" || elementType == "AWS/S3") {
+				jsonResp, cloudwatchMetricResp, err := S3.GetS3ReplicationPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting s3 replication: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "configuration_panel" && (elementType == "S3" || elementType == "AWS/S3") {
+				jsonResp, table, err := S3.GetS3ConfigurationPanel(cmd, clientAuth)
+				if err != nil {
+					log.Println("Error getting s3 configuration: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(table)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "cpu_utilization_panel" && (elementType == "ElastiCache" || elementType == "AWS/ElastiCache") {
+				jsonResp, cloudwatchMetricResp, err := ElastiCache.GetElastiCacheCpuUtilizationPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting elasticache cpu utilization: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "memory_usage_panel" && (elementType == "ElastiCache" || elementType == "AWS/ElastiCache") {
+				jsonResp, cloudwatchMetricResp, err := ElastiCache.GetElastiCacheMemoryUsagePanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting elasticache memory usage: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "cache_hit_rate_panel" && (elementType == "ElastiCache" || elementType == "AWS/ElastiCache") {
+				jsonResp, cloudwatchMetricResp, err := ElastiCache.GetElastiCacheCacheHitRatePanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting elasticache cache hit rate: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "evictions_panel" && (elementType == "ElastiCache" || elementType == "AWS/ElastiCache") {
+				jsonResp, cloudwatchMetricResp, err := ElastiCache.GetElastiCacheEvictionsPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting elasticache evictions: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "connections_panel" && (elementType == "ElastiCache" || elementType == "AWS/ElastiCache") {
+				jsonResp, cloudwatchMetricResp, err := ElastiCache.GetElastiCacheConnectionsPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting elasticache connections: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "replication_lag_panel" && (elementType == "ElastiCache" || elementType == "AWS/ElastiCache") {
+				jsonResp, cloudwatchMetricResp, err := ElastiCache.GetElastiCacheReplicationLagPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting elasticache replication lag: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "network_panel" && (elementType == "ElastiCache" || elementType == "AWS/ElastiCache") {
+				jsonResp, cloudwatchMetricResp, err := ElastiCache.GetElastiCacheNetworkPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting elasticache network: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "failover_events_panel" && (elementType == "ElastiCache" || elementType == "AWS/ElastiCache") {
+				jsonResp, cloudwatchMetricResp, err := ElastiCache.GetElastiCacheFailoverEventsPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting elasticache failover events: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "requests_panel" && (elementType == "CloudFront" || elementType == "AWS/CloudFront") {
+				jsonResp, cloudwatchMetricResp, err := CloudFront.GetCloudFrontRequestsPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting cloudfront requests: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "bytes_panel" && (elementType == "CloudFront" || elementType == "AWS/CloudFront") {
+				jsonResp, cloudwatchMetricResp, err := CloudFront.GetCloudFrontBytesPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting cloudfront bytes: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "error_rate_panel" && (elementType == "CloudFront" || elementType == "AWS/CloudFront") {
+				jsonResp, cloudwatchMetricResp, err := CloudFront.GetCloudFrontErrorRatePanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting cloudfront error rate: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "cache_hit_rate_panel" && (elementType == "CloudFront" || elementType == "AWS/CloudFront") {
+				jsonResp, cloudwatchMetricResp, err := CloudFront.GetCloudFrontCacheHitRatePanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting cloudfront cache hit rate: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "origin_latency_panel" && (elementType == "CloudFront" || elementType == "AWS/CloudFront") {
+				jsonResp, cloudwatchMetricResp, err := CloudFront.GetCloudFrontOriginLatencyPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting cloudfront origin latency: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "top_errors_panel" && (elementType == "CloudFront" || elementType == "AWS/CloudFront") {
+				jsonResp, table, err := CloudFront.GetCloudFrontTopErrorsPanel(cmd, clientAuth)
+				if err != nil {
+					log.Println("Error getting cloudfront top errors: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(table)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "executions_panel" && (elementType == "StepFunctions" || elementType == "AWS/States") {
+				jsonResp, cloudwatchMetricResp, err := StepFunctions.GetStepFunctionsExecutionsPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting step functions executions: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "execution_time_panel" && (elementType == "StepFunctions" || elementType == "AWS/States") {
+				jsonResp, cloudwatchMetricResp, err := StepFunctions.GetStepFunctionsExecutionTimePanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting step functions execution time: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "execution_throttled_panel" && (elementType == "StepFunctions" || elementType == "AWS/States") {
+				jsonResp, cloudwatchMetricResp, err := StepFunctions.GetStepFunctionsExecutionThrottledPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting step functions execution throttled: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "integration_failures_panel" && (elementType == "StepFunctions" || elementType == "AWS/States") {
+				jsonResp, cloudwatchMetricResp, err := StepFunctions.GetStepFunctionsIntegrationFailuresPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting step functions integration failures: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "failed_executions_panel" && (elementType == "StepFunctions" || elementType == "AWS/States") {
+				jsonResp, table, err := StepFunctions.GetStepFunctionsFailedExecutionsPanel(cmd, clientAuth)
+				if err != nil {
+					log.Println("Error getting step functions failed executions: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(table)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "incoming_data_panel" && (elementType == "Kinesis" || elementType == "AWS/Kinesis") {
+				jsonResp, cloudwatchMetricResp, err := Kinesis.GetKinesisIncomingDataPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting kinesis incoming data: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "iterator_age_panel" && (elementType == "Kinesis" || elementType == "AWS/Kinesis") {
+				jsonResp, cloudwatchMetricResp, err := Kinesis.GetKinesisIteratorAgePanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting kinesis iterator age: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "throughput_exceeded_panel" && (elementType == "Kinesis" || elementType == "AWS/Kinesis") {
+				jsonResp, cloudwatchMetricResp, err := Kinesis.GetKinesisThroughputExceededPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting kinesis throughput exceeded: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "put_record_panel" && (elementType == "Kinesis" || elementType == "AWS/Kinesis") {
+				jsonResp, cloudwatchMetricResp, err := Kinesis.GetKinesisPutRecordPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting kinesis put record: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "shard_metrics_panel" && (elementType == "Kinesis" || elementType == "AWS/Kinesis") {
+				jsonResp, cloudwatchMetricResp, err := Kinesis.GetKinesisShardMetricsPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting kinesis shard metrics: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "hot_shards_panel" && (elementType == "Kinesis" || elementType == "AWS/Kinesis") {
+				jsonResp, table, err := Kinesis.GetKinesisHotShardsPanel(cmd, clientAuth)
+				if err != nil {
+					log.Println("Error getting kinesis hot shards: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(table)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "messages_published_panel" && (elementType == "SNS" || elementType == "AWS/SNS") {
+				jsonResp, cloudwatchMetricResp, err := SNS.GetSNSMessagesPublishedPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting sns messages published: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "notifications_panel" && (elementType == "SNS" || elementType == "AWS/SNS") {
+				jsonResp, cloudwatchMetricResp, err := SNS.GetSNSNotificationsPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting sns notifications: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "filtered_notifications_panel" && (elementType == "SNS" || elementType == "AWS/SNS") {
+				jsonResp, cloudwatchMetricResp, err := SNS.GetSNSFilteredNotificationsPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting sns filtered notifications: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "sms_spend_panel" && (elementType == "SNS" || elementType == "AWS/SNS") {
+				jsonResp, cloudwatchMetricResp, err := SNS.GetSNSSMSSpendPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting sns sms spend: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "protocol_failures_panel" && (elementType == "SNS" || elementType == "AWS/SNS") {
+				jsonResp, table, err := SNS.GetSNSProtocolFailuresPanel(cmd, clientAuth)
+				if err != nil {
+					log.Println("Error getting sns protocol failures: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(table)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "subscriptions_panel" && (elementType == "SNS" || elementType == "AWS/SNS") {
+				jsonResp, table, err := SNS.GetSNSSubscriptionsPanel(cmd, clientAuth)
+				if err != nil {
+					log.Println("Error getting sns subscriptions: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(table)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "aurora_replica_lag_panel" && (elementType == "AuroraCluster" || elementType == "RDS" || elementType == "AWS/RDS") {
+				jsonResp, cloudwatchMetricResp, err := RDS.GetAuroraReplicaLagPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting aurora replica lag: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "aurora_volume_panel" && (elementType == "AuroraCluster" || elementType == "RDS" || elementType == "AWS/RDS") {
+				jsonResp, cloudwatchMetricResp, err := RDS.GetAuroraVolumePanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting aurora volume: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "aurora_volume_iops_panel" && (elementType == "AuroraCluster" || elementType == "RDS" || elementType == "AWS/RDS") {
+				jsonResp, cloudwatchMetricResp, err := RDS.GetAuroraVolumeIopsPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting aurora volume iops: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "aurora_serverless_capacity_panel" && (elementType == "AuroraCluster" || elementType == "RDS" || elementType == "AWS/RDS") {
+				jsonResp, cloudwatchMetricResp, err := RDS.GetAuroraServerlessCapacityPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting aurora serverless capacity: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "aurora_buffer_cache_hit_ratio_panel" && (elementType == "AuroraCluster" || elementType == "RDS" || elementType == "AWS/RDS") {
+				jsonResp, cloudwatchMetricResp, err := RDS.GetAuroraBufferCacheHitRatioPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting aurora buffer cache hit ratio: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "aurora_deadlocks_panel" && (elementType == "AuroraCluster" || elementType == "RDS" || elementType == "AWS/RDS") {
+				jsonResp, cloudwatchMetricResp, err := RDS.GetAuroraDeadlocksPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting aurora deadlocks: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "aurora_cluster_members_panel" && (elementType == "AuroraCluster" || elementType == "RDS" || elementType == "AWS/RDS") {
+				jsonResp, table, err := RDS.GetAuroraClusterMembersPanel(cmd, clientAuth)
+				if err != nil {
+					log.Println("Error getting aurora cluster members: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(table)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "client_connections_panel" && (elementType == "EFS" || elementType == "AWS/EFS") {
+				jsonResp, cloudwatchMetricResp, err := EFS.GetEFSClientConnectionsPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting efs client connections: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "io_bytes_panel" && (elementType == "EFS" || elementType == "AWS/EFS") {
+				jsonResp, cloudwatchMetricResp, err := EFS.GetEFSIOBytesPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting efs io bytes: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "percent_io_limit_panel" && (elementType == "EFS" || elementType == "AWS/EFS") {
+				jsonResp, cloudwatchMetricResp, err := EFS.GetEFSPercentIOLimitPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting efs percent io limit: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "burst_credit_balance_panel" && (elementType == "EFS" || elementType == "AWS/EFS") {
+				jsonResp, cloudwatchMetricResp, err := EFS.GetEFSBurstCreditBalancePanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting efs burst credit balance: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "throughput_panel" && (elementType == "EFS" || elementType == "AWS/EFS") {
+				jsonResp, cloudwatchMetricResp, err := EFS.GetEFSThroughputPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting efs throughput: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "storage_bytes_panel" && (elementType == "EFS" || elementType == "AWS/EFS") {
+				jsonResp, cloudwatchMetricResp, err := EFS.GetEFSStorageBytesPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting efs storage bytes: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "bytes_panel" && (elementType == "NATGateway" || elementType == "AWS/NATGateway") {
+				jsonResp, cloudwatchMetricResp, err := NATGateway.GetNATGatewayBytesPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting nat gateway bytes: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "active_connections_panel" && (elementType == "NATGateway" || elementType == "AWS/NATGateway") {
+				jsonResp, cloudwatchMetricResp, err := NATGateway.GetNATGatewayActiveConnectionsPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting nat gateway active connections: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "connections_panel" && (elementType == "NATGateway" || elementType == "AWS/NATGateway") {
+				jsonResp, cloudwatchMetricResp, err := NATGateway.GetNATGatewayConnectionsPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting nat gateway connections: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "error_port_allocation_panel" && (elementType == "NATGateway" || elementType == "AWS/NATGateway") {
+				jsonResp, cloudwatchMetricResp, err := NATGateway.GetNATGatewayErrorPortAllocationPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting nat gateway port allocation errors: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "packets_drop_panel" && (elementType == "NATGateway" || elementType == "AWS/NATGateway") {
+				jsonResp, cloudwatchMetricResp, err := NATGateway.GetNATGatewayPacketsDropPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting nat gateway dropped packets: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "idle_timeout_panel" && (elementType == "NATGateway" || elementType == "AWS/NATGateway") {
+				jsonResp, cloudwatchMetricResp, err := NATGateway.GetNATGatewayIdleTimeoutPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting nat gateway idle timeouts: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "data_processing_cost_panel" && (elementType == "NATGateway" || elementType == "AWS/NATGateway") {
+				jsonResp, cloudwatchMetricResp, err := NATGateway.GetNATGatewayDataProcessingCostPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting nat gateway data processing cost: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "broker_cpu_panel" && (elementType == "MSK" || elementType == "AWS/Kafka") {
+				jsonResp, cloudwatchMetricResp, err := MSK.GetMSKBrokerCpuPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting msk broker cpu: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "disk_used_panel" && (elementType == "MSK" || elementType == "AWS/Kafka") {
+				jsonResp, cloudwatchMetricResp, err := MSK.GetMSKDiskUsedPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting msk disk used: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "under_replicated_partitions_panel" && (elementType == "MSK" || elementType == "AWS/Kafka") {
+				jsonResp, cloudwatchMetricResp, err := MSK.GetMSKUnderReplicatedPartitionsPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting msk under replicated partitions: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "offline_partitions_panel" && (elementType == "MSK" || elementType == "AWS/Kafka") {
+				jsonResp, cloudwatchMetricResp, err := MSK.GetMSKOfflinePartitionsPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting msk offline partitions: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "active_controller_panel" && (elementType == "MSK" || elementType == "AWS/Kafka") {
+				jsonResp, cloudwatchMetricResp, err := MSK.GetMSKActiveControllerPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting msk active controller count: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "topic_throughput_panel" && (elementType == "MSK" || elementType == "AWS/Kafka") {
+				jsonResp, cloudwatchMetricResp, err := MSK.GetMSKTopicThroughputPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting msk topic throughput: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "consumer_lag_panel" && (elementType == "MSK" || elementType == "AWS/Kafka") {
+				jsonResp, cloudwatchMetricResp, err := MSK.GetMSKConsumerLagPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting msk consumer lag: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "broker_network_panel" && (elementType == "MSK" || elementType == "AWS/Kafka") {
+				jsonResp, cloudwatchMetricResp, err := MSK.GetMSKBrokerNetworkPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting msk broker network: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "cluster_status_panel" && (elementType == "OpenSearch" || elementType == "AWS/ES") {
+				jsonResp, cloudwatchMetricResp, err := OpenSearch.GetOpenSearchClusterStatusPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting opensearch cluster status: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "free_storage_panel" && (elementType == "OpenSearch" || elementType == "AWS/ES") {
+				jsonResp, cloudwatchMetricResp, err := OpenSearch.GetOpenSearchFreeStoragePanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting opensearch free storage: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "jvm_memory_pressure_panel" && (elementType == "OpenSearch" || elementType == "AWS/ES") {
+				jsonResp, cloudwatchMetricResp, err := OpenSearch.GetOpenSearchJVMMemoryPressurePanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting opensearch jvm memory pressure: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "cpu_utilization_panel" && (elementType == "OpenSearch" || elementType == "AWS/ES") {
+				jsonResp, cloudwatchMetricResp, err := OpenSearch.GetOpenSearchCPUUtilizationPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting opensearch cpu utilization: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "latency_panel" && (elementType == "OpenSearch" || elementType == "AWS/ES") {
+				jsonResp, cloudwatchMetricResp, err := OpenSearch.GetOpenSearchLatencyPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting opensearch latency: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "threadpool_rejected_panel" && (elementType == "OpenSearch" || elementType == "AWS/ES") {
+				jsonResp, cloudwatchMetricResp, err := OpenSearch.GetOpenSearchThreadpoolRejectedPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting opensearch threadpool rejections: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "automated_snapshot_failure_panel" && (elementType == "OpenSearch" || elementType == "AWS/ES") {
+				jsonResp, cloudwatchMetricResp, err := OpenSearch.GetOpenSearchAutomatedSnapshotFailurePanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting opensearch automated snapshot failures: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "master_health_panel" && (elementType == "OpenSearch" || elementType == "AWS/ES") {
+				jsonResp, cloudwatchMetricResp, err := OpenSearch.GetOpenSearchMasterHealthPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting opensearch master health: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "health_check_status_panel" && (elementType == "Route53" || elementType == "AWS/Route53") {
+				jsonResp, cloudwatchMetricResp, err := Route53.GetRoute53HealthCheckStatusPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting route53 health check status: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "checker_latency_panel" && (elementType == "Route53" || elementType == "AWS/Route53") {
+				jsonResp, cloudwatchMetricResp, err := Route53.GetRoute53CheckerLatencyPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting route53 checker latency: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "health_check_uptime_panel" && (elementType == "Route53" || elementType == "AWS/Route53") {
+				jsonResp, cloudwatchMetricResp, err := Route53.GetRoute53HealthCheckUptimePanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting route53 health check uptime: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "dns_queries_panel" && (elementType == "Route53" || elementType == "AWS/Route53") {
+				jsonResp, cloudwatchMetricResp, err := Route53.GetRoute53DNSQueriesPanel(cmd, clientAuth, nil)
+				if err != nil {
+					log.Println("Error getting route53 dns queries: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(cloudwatchMetricResp)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else if queryName == "query_volume_panel" && (elementType == "Route53" || elementType == "AWS/Route53") {
+				jsonResp, table, err := Route53.GetRoute53QueryVolumePanel(cmd, clientAuth)
+				if err != nil {
+					log.Println("Error getting route53 query volume: ", err)
+					return
+				}
+				if responseType == "frame" {
+					fmt.Println(table)
+				} else {
+					fmt.Println(jsonResp)
+				}
+			} else {
+				fmt.Println("query not found")
+			}
+		}
+	},
+}
+
+func Execute() {
+	if err := AwsxCloudWatchMetricsCmd.Execute(); err != nil {
+		log.Printf("error executing command: %v\n", err)
+	}
+}
+
+func init() {
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2CustomAlertPanelCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2CpuUtilizationCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2MemoryUtilizationCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2NetworkUtilizationCmd)
+	// AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2StorageUtilizationCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2CpuUsageUserCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2CpuUsageUserCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2CpuUsageIdleCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2CpuSysTimeCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2CpuUsageNiceCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2MemCachedCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2MemoryUsageTotalCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2MemoryUsageUsedCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2MemoryUsageFreeCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2NetworkInBytesCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2NetworkOutBytesCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2NetworkOutPacketsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2NetworkInPacketsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2DiskReadCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2DiskWriteCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2DiskUsedCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2hostedServicesCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2CpuUtilizationGraphsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2MemoryUtilizationGraphCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.ListErrorsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEC2NetworkTrafficCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2DiskAvailableCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2NetworkInboundCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2NetworkOutboundCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2AlarmandNotificationcmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2InstanceStopCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEC2DiskIOPerformanceCmd)
+	//AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2InstanceStopCmdTest)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2NetworkOutBytesCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2InstanceStatusCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2ErrorRatePanelCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2InstanceHealthCheckCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2MeanTimeToRecoveryCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2EBSVolumeIOPSCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2EBSVolumeThroughputCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2EBSVolumeQueueLengthCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2EBSBurstBalanceCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2EBSVolumeIdleTimeCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EC2.AwsxEc2EBSVolumeLatencyCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSAllocatableCpuCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSCpuLimitsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSCpuRequestsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSCpuUtilizationCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSCpuUtilizationGraphCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSCpuUtilizationNodeGraphCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSDiskUtilizationCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSMemoryLimitsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSMemoryRequestsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSMemoryUsageCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSMemoryUtilizationCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSMemoryUtilizationGraphCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSNetworkAvailabilityCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSNetworkInOutCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSNetworkThroughputCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSNetworkThroughputSingleCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSNetworkUtilizationCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSNodeCapacityCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSNodeStabilityCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSIncidentResponseTimeCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSNodeDowntimeCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSNodeEventLogsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSNodeUptimeCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSServiceAvailabilityCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSStorageUtilizationCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EKS.AwsxEKSMeanTimeToRecoveryCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxECSCpuUtilizationCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxECSCpuUtilizationGraphCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxCpuReservedCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxECSMemoryUtilizationCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxECSMemoryUtilizationGraphCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxMemoryReservedCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxECSStorageUtilizationCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxECSNetworkRxInBytesCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxECSNetworkTxInBytesCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxECSReadBytesCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxECSWriteBytesCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxResourceCreatedPanelCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxResourceDeletedPanelCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxResourceUpdatedPanelCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxEcsServiceErrorCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxECSUptimeCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ECS.AwsxECSMeanTimeToRecoveryCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(Lambda.AwsxLambdaCpuCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(Lambda.AwsxLambdaFailureCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(Lambda.AwsxLambdaSuccessFailureCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(Lambda.AwsxLambdaNumberOfCallsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSCpuUtilizationCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSNetworkTrafficCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSNetworkUtilizationCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSFreeStorageSpaceCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSFreeableMemoryCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSDiskQueueDepthCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSNetworkTrafficCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSReplicationSlotDiskUsageCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSNetworkReceiveThroughputCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSNetworkTransmitThroughputCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSDBLoadCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSDBLoadNonCPUCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSDBLoadCPUCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSStorageUtilizationCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSLatencyAnalysisCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSCPUCreditUsageCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSCPUSurplusCreditBalanceCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSSurplusCreditsChargedCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSCpuUtilizationGraphCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSDatabaseConnectionsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSDBLoadCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSIndexSizeCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxDBInstanceHealthCheckCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSIopsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSReadIOPSCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSTransactionLogsDiskCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSTransactionLogsGenCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSWriteIOPSCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSErrorAnalysisCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSUptimeCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSMemoryUtilizationCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxRDSMeanTimeToRecoveryCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ApiGateway.ApiResponseTimeCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ApiGateway.AwsxApiCacheHitsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ApiGateway.AwsxApiCacheMissCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ApiGateway.AwsxApiDowntimeIncidentsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ApiGateway.AwsxApiUptimeCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ApiGateway.AwsxApiDeploymentCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ApiGateway.AwsxApiCallsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(NLB.AwsxNLBActiveConnectionsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(NLB.AwsxNLBNewConnectionsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(NLB.AwsxNLBProcessedBytesCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(NLB.AwsxNLBHealthyHostCountCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(NLB.AwsxNLBUnhealthyHostCountCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(NLB.AwsxNLBSSLTLSNegotiationCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(NLB.AwsxNLBTargetHealthChecksCmd)
+
+	AwsxCloudWatchMetricsCmd.AddCommand(ALB.AwsxALBRequestCountCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ALB.AwsxALBHTTPCodeCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ALB.AwsxALBTargetResponseTimeCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ALB.AwsxALBRejectedConnectionsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ALB.AwsxALBTargetGroupHostCountCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ALB.AwsxALBLCUConsumptionCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ALB.AwsxALBTargetStatusCmd)
+
+	AwsxCloudWatchMetricsCmd.AddCommand(DynamoDB.AwsxDynamoDBCapacityUtilizationCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(DynamoDB.AwsxDynamoDBThrottleEventsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(DynamoDB.AwsxDynamoDBLatencyByOperationCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(DynamoDB.AwsxDynamoDBErrorsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(DynamoDB.AwsxDynamoDBGSICapacityCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(DynamoDB.AwsxDynamoDBCapacityModeCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(DynamoDB.AwsxDynamoDBTableSizeCmd)
+
+	AwsxCloudWatchMetricsCmd.AddCommand(SQS.AwsxSQSMessagesCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(SQS.AwsxSQSOldestMessageAgeCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(SQS.AwsxSQSMessageRatesCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(SQS.AwsxSQSEmptyReceivesCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(SQS.AwsxSQSDeadLetterQueueDepthCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(SQS.AwsxSQSTimeToDrainCmd)
+
+	AwsxCloudWatchMetricsCmd.AddCommand(S3.AwsxS3StorageCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(S3.AwsxS3RequestMetricsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(S3.AwsxS3ReplicationCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(S3.AwsxS3ConfigurationCmd)
+
+	AwsxCloudWatchMetricsCmd.AddCommand(ElastiCache.AwsxElastiCacheCpuUtilizationCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ElastiCache.AwsxElastiCacheMemoryUsageCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ElastiCache.AwsxElastiCacheCacheHitRateCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ElastiCache.AwsxElastiCacheEvictionsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ElastiCache.AwsxElastiCacheConnectionsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ElastiCache.AwsxElastiCacheReplicationLagCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ElastiCache.AwsxElastiCacheNetworkCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(ElastiCache.AwsxElastiCacheFailoverEventsCmd)
+
+	AwsxCloudWatchMetricsCmd.AddCommand(CloudFront.AwsxCloudFrontRequestsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(CloudFront.AwsxCloudFrontBytesCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(CloudFront.AwsxCloudFrontErrorRateCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(CloudFront.AwsxCloudFrontCacheHitRateCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(CloudFront.AwsxCloudFrontOriginLatencyCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(CloudFront.AwsxCloudFrontTopErrorsCmd)
+
+	AwsxCloudWatchMetricsCmd.AddCommand(StepFunctions.AwsxStepFunctionsExecutionsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(StepFunctions.AwsxStepFunctionsExecutionTimeCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(StepFunctions.AwsxStepFunctionsExecutionThrottledCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(StepFunctions.AwsxStepFunctionsIntegrationFailuresCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(StepFunctions.AwsxStepFunctionsFailedExecutionsCmd)
+
+	AwsxCloudWatchMetricsCmd.AddCommand(Kinesis.AwsxKinesisIncomingDataCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(Kinesis.AwsxKinesisIteratorAgeCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(Kinesis.AwsxKinesisThroughputExceededCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(Kinesis.AwsxKinesisPutRecordCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(Kinesis.AwsxKinesisShardMetricsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(Kinesis.AwsxKinesisHotShardsCmd)
+
+	AwsxCloudWatchMetricsCmd.AddCommand(SNS.AwsxSNSMessagesPublishedCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(SNS.AwsxSNSNotificationsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(SNS.AwsxSNSFilteredNotificationsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(SNS.AwsxSNSSMSSpendCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(SNS.AwsxSNSProtocolFailuresCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(SNS.AwsxSNSSubscriptionsCmd)
+
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxAuroraReplicaLagCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxAuroraVolumeCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxAuroraVolumeIopsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxAuroraServerlessCapacityCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxAuroraBufferCacheHitRatioCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxAuroraDeadlocksCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(RDS.AwsxAuroraClusterMembersCmd)
+
+	AwsxCloudWatchMetricsCmd.AddCommand(EFS.AwsxEFSClientConnectionsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EFS.AwsxEFSIOBytesCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EFS.AwsxEFSPercentIOLimitCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EFS.AwsxEFSBurstCreditBalanceCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EFS.AwsxEFSThroughputCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(EFS.AwsxEFSStorageBytesCmd)
+
+	AwsxCloudWatchMetricsCmd.AddCommand(NATGateway.AwsxNATGatewayBytesCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(NATGateway.AwsxNATGatewayActiveConnectionsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(NATGateway.AwsxNATGatewayConnectionsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(NATGateway.AwsxNATGatewayErrorPortAllocationCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(NATGateway.AwsxNATGatewayPacketsDropCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(NATGateway.AwsxNATGatewayIdleTimeoutCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(NATGateway.AwsxNATGatewayDataProcessingCostCmd)
+
+	AwsxCloudWatchMetricsCmd.AddCommand(MSK.AwsxMSKBrokerCpuCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(MSK.AwsxMSKDiskUsedCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(MSK.AwsxMSKUnderReplicatedPartitionsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(MSK.AwsxMSKOfflinePartitionsCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(MSK.AwsxMSKActiveControllerCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(MSK.AwsxMSKTopicThroughputCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(MSK.AwsxMSKConsumerLagCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(MSK.AwsxMSKBrokerNetworkCmd)
+
+	AwsxCloudWatchMetricsCmd.AddCommand(OpenSearch.AwsxOpenSearchClusterStatusCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(OpenSearch.AwsxOpenSearchFreeStorageCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(OpenSearch.AwsxOpenSearchJVMMemoryPressureCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(OpenSearch.AwsxOpenSearchCPUUtilizationCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(OpenSearch.AwsxOpenSearchLatencyCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(OpenSearch.AwsxOpenSearchThreadpoolRejectedCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(OpenSearch.AwsxOpenSearchAutomatedSnapshotFailureCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(OpenSearch.AwsxOpenSearchMasterHealthCmd)
+
+	AwsxCloudWatchMetricsCmd.AddCommand(Route53.AwsxRoute53HealthCheckStatusCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(Route53.AwsxRoute53CheckerLatencyCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(Route53.AwsxRoute53HealthCheckUptimeCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(Route53.AwsxRoute53DNSQueriesCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(Route53.AwsxRoute53QueryVolumeCmd)
+
+	AwsxCloudWatchMetricsCmd.AddCommand(AwsxCoverageCmd)
+	AwsxCloudWatchMetricsCmd.AddCommand(AwsxPanelMappingCmd)
 
 	AwsxCloudWatchMetricsCmd.PersistentFlags().String("rootvolumeId", "", "root volume id")
 	AwsxCloudWatchMetricsCmd.PersistentFlags().String("ebsvolume1Id", "", "ebs volume 1 id")
@@ -2453,5 +3765,32 @@ func init() {
 	AwsxCloudWatchMetricsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
 	AwsxCloudWatchMetricsCmd.PersistentFlags().String("logGroupName", "", "log group name")
 	AwsxCloudWatchMetricsCmd.PersistentFlags().String("loadBalancerArn", "", "NLB Load Balancer ARN")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("regions", "", "comma separated regions. defaults to the regions enabled for the account")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("mode", "", "panel mode. analysis returns security findings")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("TaskDefinitionFamily", "", "ECS task definition family")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("groupBy", "", "group series by. service for ECS panels, namespace/pod/node for EKS panels, cluster/role/instance for Aurora panels")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("topN", "", "number of series returned with groupBy, or of rows of top n tables. defaults to 10")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("Namespace", "", "kubernetes namespace")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("PodName", "", "pod name")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("stat", "", "comma separated statistics for latency panels. Average/Sum/Minimum/Maximum/SampleCount, p50/p90/p95/p99, TM90, IQM")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("TableName", "", "dynamodb table name")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("GlobalSecondaryIndexName", "", "dynamodb global secondary index name")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("Operation", "", "dynamodb operation")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("QueueName", "", "sqs queue name")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("BucketName", "", "s3 bucket name")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("FilterId", "", "s3 request metrics configuration id")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("ReplicationGroupId", "", "elasticache replication group or cache cluster id")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("DistributionId", "", "cloudfront distribution id")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("StateMachineArn", "", "step functions state machine arn")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("StreamName", "", "kinesis stream name")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("TopicName", "", "sns topic name or arn")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("DBClusterIdentifier", "", "aurora cluster identifier")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("FileSystemId", "", "efs file system id")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("NatGatewayId", "", "nat gateway id")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("pricePerGB", "", "data processing price per GB. defaults to the us-east-1 price")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("DomainName", "", "opensearch domain name or arn")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("ClientId", "", "account id of the opensearch domain. looked up when not given")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("HealthCheckId", "", "route 53 health check id")
+	AwsxCloudWatchMetricsCmd.PersistentFlags().String("HostedZoneId", "", "route 53 hosted zone id")
 
 }
@@ -0,0 +1,51 @@
+package ALB
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+const namespace = "AWS/ApplicationELB"
+
+// period is the granularity ALB publishes its metrics at
+const period = 60
+
+// GetALBMetricPanel resolves the load balancer and returns the given series of it.
+func GetALBMetricPanel(cmd *cobra.Command, clientAuth *model.Auth, series []Metric.Series, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	_, loadBalancer, err := GetALBLoadBalancer(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	dimensions := []*cloudwatch.Dimension{
+		{
+			Name:  aws.String("LoadBalancer"),
+			Value: aws.String(loadBalancer),
+		},
+	}
+	result, cloudwatchMetricData, err := Metric.GetMetricData(clientAuth, namespace, period, dimensions, series, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting load balancer metric data: ", err)
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
@@ -0,0 +1,76 @@
+package ALB
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var httpCodeSeries = []Metric.Series{
+	{Label: "Target_2XX", MetricName: "HTTPCode_Target_2XX_Count", Statistic: "Sum"},
+	{Label: "Target_4XX", MetricName: "HTTPCode_Target_4XX_Count", Statistic: "Sum"},
+	{Label: "Target_5XX", MetricName: "HTTPCode_Target_5XX_Count", Statistic: "Sum"},
+	{Label: "ELB_5XX", MetricName: "HTTPCode_ELB_5XX_Count", Statistic: "Sum"},
+}
+
+var AwsxALBHTTPCodeCmd = &cobra.Command{
+	Use:   "alb_http_code_panel",
+	Short: "get ALB http response code metrics data",
+	Long:  `command to get ALB target 2XX/4XX/5XX and ELB 5XX response counts`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetALBHTTPCodePanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting ALB http code counts: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetALBHTTPCodePanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetALBMetricPanel(cmd, clientAuth, httpCodeSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxALBHTTPCodeCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxALBHTTPCodeCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxALBHTTPCodeCmd.PersistentFlags().String("query", "", "query")
+	AwsxALBHTTPCodeCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxALBHTTPCodeCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxALBHTTPCodeCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxALBHTTPCodeCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxALBHTTPCodeCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxALBHTTPCodeCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxALBHTTPCodeCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxALBHTTPCodeCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxALBHTTPCodeCmd.PersistentFlags().String("instanceId", "", "load balancer dimension value. app/<name>/<id>")
+	AwsxALBHTTPCodeCmd.PersistentFlags().String("loadBalancerArn", "", "ALB Load Balancer ARN")
+	AwsxALBHTTPCodeCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxALBHTTPCodeCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxALBHTTPCodeCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,73 @@
+package ALB
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var lcuConsumptionSeries = []Metric.Series{
+	{Label: "ConsumedLCUs", MetricName: "ConsumedLCUs", Statistic: "Sum"},
+}
+
+var AwsxALBLCUConsumptionCmd = &cobra.Command{
+	Use:   "alb_lcu_consumption_panel",
+	Short: "get ALB load balancer capacity units consumed",
+	Long:  `command to get the load balancer capacity units (LCU) consumed by the ALB`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetALBLCUConsumptionPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting ALB lcu consumption: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetALBLCUConsumptionPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetALBMetricPanel(cmd, clientAuth, lcuConsumptionSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxALBLCUConsumptionCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxALBLCUConsumptionCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxALBLCUConsumptionCmd.PersistentFlags().String("query", "", "query")
+	AwsxALBLCUConsumptionCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxALBLCUConsumptionCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxALBLCUConsumptionCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxALBLCUConsumptionCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxALBLCUConsumptionCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxALBLCUConsumptionCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxALBLCUConsumptionCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxALBLCUConsumptionCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxALBLCUConsumptionCmd.PersistentFlags().String("instanceId", "", "load balancer dimension value. app/<name>/<id>")
+	AwsxALBLCUConsumptionCmd.PersistentFlags().String("loadBalancerArn", "", "ALB Load Balancer ARN")
+	AwsxALBLCUConsumptionCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxALBLCUConsumptionCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxALBLCUConsumptionCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
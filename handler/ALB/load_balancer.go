@@ -0,0 +1,100 @@
+package ALB
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/cmdb"
+	"github.com/Appkube-awsx/awsx-common/config"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/spf13/cobra"
+)
+
+// GetALBLoadBalancer returns the load balancer ARN and its LoadBalancer dimension value
+// (app/<name>/<id>). The ARN comes from the loadBalancerArn flag or the CMDB element.
+// Without an ARN the instanceId flag is taken as the dimension value, as the NLB panels
+// do, and the ARN is left empty.
+func GetALBLoadBalancer(cmd *cobra.Command) (string, string, error) {
+	loadBalancerArn, _ := cmd.PersistentFlags().GetString("loadBalancerArn")
+	instanceId, _ := cmd.PersistentFlags().GetString("instanceId")
+	elementId, _ := cmd.PersistentFlags().GetString("elementId")
+	cmdbApiUrl, _ := cmd.PersistentFlags().GetString("cmdbApiUrl")
+
+	if loadBalancerArn == "" && elementId != "" {
+		log.Println("getting cloud-element data from cmdb")
+		apiUrl := cmdbApiUrl
+		if cmdbApiUrl == "" {
+			log.Println("using default cmdb url")
+			apiUrl = config.CmdbUrl
+		}
+		log.Println("cmdb url: " + apiUrl)
+		cmdbData, err := cmdb.GetCloudElementData(apiUrl, elementId)
+		if err != nil {
+			return "", "", err
+		}
+		loadBalancerArn = cmdbData.Arn
+		instanceId = cmdbData.InstanceId
+	}
+
+	if loadBalancerArn != "" {
+		return loadBalancerArn, loadBalancerDimension(loadBalancerArn), nil
+	}
+	if instanceId != "" {
+		return "", instanceId, nil
+	}
+	return "", "", errors.New("load balancer not found. provide loadBalancerArn or elementId")
+}
+
+// resolveALBArn returns the load balancer ARN, looking it up by dimension value when only
+// the instanceId flag was given.
+func resolveALBArn(cmd *cobra.Command, elbClient *elbv2.ELBV2) (string, error) {
+	loadBalancerArn, loadBalancer, err := GetALBLoadBalancer(cmd)
+	if err != nil {
+		return "", err
+	}
+	if loadBalancerArn != "" {
+		return loadBalancerArn, nil
+	}
+
+	err = elbClient.DescribeLoadBalancersPages(&elbv2.DescribeLoadBalancersInput{}, func(page *elbv2.DescribeLoadBalancersOutput, lastPage bool) bool {
+		for _, lb := range page.LoadBalancers {
+			if loadBalancerDimension(aws.StringValue(lb.LoadBalancerArn)) == loadBalancer {
+				loadBalancerArn = aws.StringValue(lb.LoadBalancerArn)
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		log.Printf("Error describing load balancers: %v", err)
+		return "", err
+	}
+	if loadBalancerArn == "" {
+		return "", fmt.Errorf("load balancer %s not found", loadBalancer)
+	}
+	return loadBalancerArn, nil
+}
+
+func getELBV2Client(clientAuth *model.Auth) *elbv2.ELBV2 {
+	return awsclient.GetClient(*clientAuth, awsclient.ELBV2_CLIENT).(*elbv2.ELBV2)
+}
+
+// loadBalancerDimension returns the LoadBalancer dimension value, which is the ARN
+// after "loadbalancer/".
+func loadBalancerDimension(arn string) string {
+	if i := strings.Index(arn, ":loadbalancer/"); i >= 0 {
+		return arn[i+len(":loadbalancer/"):]
+	}
+	return arn
+}
+
+// targetGroupDimension returns the TargetGroup dimension value (targetgroup/<name>/<id>),
+// which is the last ARN field.
+func targetGroupDimension(arn string) string {
+	return arn[strings.LastIndex(arn, ":")+1:]
+}
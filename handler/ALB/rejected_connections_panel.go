@@ -0,0 +1,73 @@
+package ALB
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var rejectedConnectionsSeries = []Metric.Series{
+	{Label: "RejectedConnectionCount", MetricName: "RejectedConnectionCount", Statistic: "Sum"},
+}
+
+var AwsxALBRejectedConnectionsCmd = &cobra.Command{
+	Use:   "alb_rejected_connections_panel",
+	Short: "get ALB rejected connections metrics data",
+	Long:  `command to get the connections rejected because the ALB reached its maximum`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetALBRejectedConnectionsPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting ALB rejected connections: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetALBRejectedConnectionsPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetALBMetricPanel(cmd, clientAuth, rejectedConnectionsSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxALBRejectedConnectionsCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxALBRejectedConnectionsCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxALBRejectedConnectionsCmd.PersistentFlags().String("query", "", "query")
+	AwsxALBRejectedConnectionsCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxALBRejectedConnectionsCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxALBRejectedConnectionsCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxALBRejectedConnectionsCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxALBRejectedConnectionsCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxALBRejectedConnectionsCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxALBRejectedConnectionsCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxALBRejectedConnectionsCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxALBRejectedConnectionsCmd.PersistentFlags().String("instanceId", "", "load balancer dimension value. app/<name>/<id>")
+	AwsxALBRejectedConnectionsCmd.PersistentFlags().String("loadBalancerArn", "", "ALB Load Balancer ARN")
+	AwsxALBRejectedConnectionsCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxALBRejectedConnectionsCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxALBRejectedConnectionsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
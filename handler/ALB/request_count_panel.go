@@ -0,0 +1,73 @@
+package ALB
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var requestCountSeries = []Metric.Series{
+	{Label: "RequestCount", MetricName: "RequestCount", Statistic: "Sum"},
+}
+
+var AwsxALBRequestCountCmd = &cobra.Command{
+	Use:   "alb_request_count_panel",
+	Short: "get ALB request count metrics data",
+	Long:  `command to get ALB request count metrics data`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetALBRequestCountPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting ALB request count: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetALBRequestCountPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetALBMetricPanel(cmd, clientAuth, requestCountSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxALBRequestCountCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxALBRequestCountCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxALBRequestCountCmd.PersistentFlags().String("query", "", "query")
+	AwsxALBRequestCountCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxALBRequestCountCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxALBRequestCountCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxALBRequestCountCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxALBRequestCountCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxALBRequestCountCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxALBRequestCountCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxALBRequestCountCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxALBRequestCountCmd.PersistentFlags().String("instanceId", "", "load balancer dimension value. app/<name>/<id>")
+	AwsxALBRequestCountCmd.PersistentFlags().String("loadBalancerArn", "", "ALB Load Balancer ARN")
+	AwsxALBRequestCountCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxALBRequestCountCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxALBRequestCountCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
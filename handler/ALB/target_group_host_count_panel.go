@@ -0,0 +1,165 @@
+package ALB
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var hostCountSeries = []Metric.Series{
+	{Label: "HealthyHostCount", MetricName: "HealthyHostCount", Statistic: "Average"},
+	{Label: "UnHealthyHostCount", MetricName: "UnHealthyHostCount", Statistic: "Average"},
+}
+
+var AwsxALBTargetGroupHostCountCmd = &cobra.Command{
+	Use:   "alb_target_group_host_count_panel",
+	Short: "get ALB healthy and unhealthy hosts per target group",
+	Long:  `command to get ALB healthy and unhealthy host counts per target group`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetALBTargetGroupHostCountPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting ALB target group host count: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetALBTargetGroupHostCountPanel returns the healthy and unhealthy host counts of every
+// target group behind the load balancer, keyed by TargetGroup dimension value.
+func GetALBTargetGroupHostCountPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	_, loadBalancer, err := GetALBLoadBalancer(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if cloudWatchClient == nil {
+		cloudWatchClient = awsclient.GetClient(*clientAuth, awsclient.CLOUDWATCH).(*cloudwatch.CloudWatch)
+	}
+
+	targetGroups, err := listTargetGroupDimensions(cloudWatchClient, loadBalancer)
+	if err != nil {
+		log.Println("Error listing target groups: ", err)
+		return "", nil, err
+	}
+
+	result := map[string]map[string][]Metric.DataPoint{}
+	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
+	for _, targetGroup := range targetGroups {
+		dimensions := []*cloudwatch.Dimension{
+			{
+				Name:  aws.String("TargetGroup"),
+				Value: aws.String(targetGroup),
+			},
+			{
+				Name:  aws.String("LoadBalancer"),
+				Value: aws.String(loadBalancer),
+			},
+		}
+		series, rawData, err := Metric.GetMetricData(clientAuth, namespace, period, dimensions, hostCountSeries, startTime, endTime, cloudWatchClient)
+		if err != nil {
+			log.Println("Error in getting host count data: ", err)
+			return "", nil, err
+		}
+		result[targetGroup] = series
+		output := &cloudwatch.GetMetricDataOutput{}
+		for _, s := range hostCountSeries {
+			output.MetricDataResults = append(output.MetricDataResults, rawData[s.Label].MetricDataResults...)
+		}
+		cloudwatchMetricData[targetGroup] = output
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+// listTargetGroupDimensions returns the TargetGroup dimension values published for the
+// load balancer. The per availability zone series are skipped.
+func listTargetGroupDimensions(cloudWatchClient *cloudwatch.CloudWatch, loadBalancer string) ([]string, error) {
+	var targetGroups []string
+	input := &cloudwatch.ListMetricsInput{
+		Namespace:  aws.String(namespace),
+		MetricName: aws.String("HealthyHostCount"),
+		Dimensions: []*cloudwatch.DimensionFilter{
+			{
+				Name:  aws.String("LoadBalancer"),
+				Value: aws.String(loadBalancer),
+			},
+		},
+	}
+	err := cloudWatchClient.ListMetricsPages(input, func(page *cloudwatch.ListMetricsOutput, lastPage bool) bool {
+		for _, metric := range page.Metrics {
+			if len(metric.Dimensions) != 2 {
+				continue
+			}
+			for _, dimension := range metric.Dimensions {
+				if aws.StringValue(dimension.Name) == "TargetGroup" {
+					targetGroups = append(targetGroups, aws.StringValue(dimension.Value))
+				}
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return targetGroups, nil
+}
+
+func init() {
+	AwsxALBTargetGroupHostCountCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxALBTargetGroupHostCountCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxALBTargetGroupHostCountCmd.PersistentFlags().String("query", "", "query")
+	AwsxALBTargetGroupHostCountCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxALBTargetGroupHostCountCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxALBTargetGroupHostCountCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxALBTargetGroupHostCountCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxALBTargetGroupHostCountCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxALBTargetGroupHostCountCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxALBTargetGroupHostCountCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxALBTargetGroupHostCountCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxALBTargetGroupHostCountCmd.PersistentFlags().String("instanceId", "", "load balancer dimension value. app/<name>/<id>")
+	AwsxALBTargetGroupHostCountCmd.PersistentFlags().String("loadBalancerArn", "", "ALB Load Balancer ARN")
+	AwsxALBTargetGroupHostCountCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxALBTargetGroupHostCountCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxALBTargetGroupHostCountCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
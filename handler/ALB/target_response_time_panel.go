@@ -0,0 +1,87 @@
+package ALB
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Statistic"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var defaultResponseTimeStatistics = []string{"p50", "p90", "p99"}
+
+var AwsxALBTargetResponseTimeCmd = &cobra.Command{
+	Use:   "alb_target_response_time_panel",
+	Short: "get ALB target response time percentiles",
+	Long:  `command to get ALB target response time percentiles`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetALBTargetResponseTimePanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting ALB target response time: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetALBTargetResponseTimePanel returns TargetResponseTime for each statistic of the stat
+// flag, or p50, p90 and p99 when it is not set.
+func GetALBTargetResponseTimePanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	statistics, err := Statistic.GetStatistics(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+	if statistics == nil {
+		statistics = defaultResponseTimeStatistics
+	}
+
+	var series []Metric.Series
+	for _, statistic := range statistics {
+		series = append(series, Metric.Series{Label: statistic, MetricName: "TargetResponseTime", Statistic: statistic})
+	}
+	return GetALBMetricPanel(cmd, clientAuth, series, cloudWatchClient)
+}
+
+func init() {
+	AwsxALBTargetResponseTimeCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxALBTargetResponseTimeCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxALBTargetResponseTimeCmd.PersistentFlags().String("query", "", "query")
+	AwsxALBTargetResponseTimeCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxALBTargetResponseTimeCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxALBTargetResponseTimeCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxALBTargetResponseTimeCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxALBTargetResponseTimeCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxALBTargetResponseTimeCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxALBTargetResponseTimeCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxALBTargetResponseTimeCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxALBTargetResponseTimeCmd.PersistentFlags().String("instanceId", "", "load balancer dimension value. app/<name>/<id>")
+	AwsxALBTargetResponseTimeCmd.PersistentFlags().String("loadBalancerArn", "", "ALB Load Balancer ARN")
+	AwsxALBTargetResponseTimeCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxALBTargetResponseTimeCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxALBTargetResponseTimeCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+	AwsxALBTargetResponseTimeCmd.PersistentFlags().String("stat", "", "comma separated statistics. defaults to p50,p90,p99")
+}
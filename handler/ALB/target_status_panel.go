@@ -0,0 +1,159 @@
+package ALB
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+type ALBTargetStatus struct {
+	TargetGroup  string `json:"targetGroup"`
+	TargetId     string `json:"targetId"`
+	Port         int64  `json:"port"`
+	TargetHealth string `json:"targetHealth"`
+	Reason       string `json:"reason"`
+	Description  string `json:"description"`
+}
+
+var AwsxALBTargetStatusCmd = &cobra.Command{
+	Use:   "alb_target_status_panel",
+	Short: "get ALB target status data",
+	Long:  `command to get the health of every target registered with the ALB target groups`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, table, err := GetALBTargetStatusPanel(cmd, clientAuth)
+			if err != nil {
+				log.Println("Error getting ALB target status: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(table)
+			} else {
+				fmt.Println(jsonResp)
+			}
+		}
+	},
+}
+
+// GetALBTargetStatusPanel returns the target health of the load balancer target groups as
+// json and as a formatted table.
+func GetALBTargetStatusPanel(cmd *cobra.Command, clientAuth *model.Auth) (string, string, error) {
+	elbClient := getELBV2Client(clientAuth)
+
+	loadBalancerArn, err := resolveALBArn(cmd, elbClient)
+	if err != nil {
+		return "", "", err
+	}
+
+	targetStatuses, err := GetALBTargetStatus(elbClient, loadBalancerArn)
+	if err != nil {
+		log.Println("Error getting target status: ", err)
+		return "", "", err
+	}
+
+	jsonString, err := json.Marshal(targetStatuses)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", "", err
+	}
+	return string(jsonString), printTargetStatusTable(targetStatuses), nil
+}
+
+// GetALBTargetStatus describes the target health of every target group of the load balancer.
+func GetALBTargetStatus(elbClient *elbv2.ELBV2, loadBalancerArn string) ([]ALBTargetStatus, error) {
+	targetStatuses := []ALBTargetStatus{}
+
+	var targetGroups []*elbv2.TargetGroup
+	input := &elbv2.DescribeTargetGroupsInput{
+		LoadBalancerArn: aws.String(loadBalancerArn),
+	}
+	err := elbClient.DescribeTargetGroupsPages(input, func(page *elbv2.DescribeTargetGroupsOutput, lastPage bool) bool {
+		targetGroups = append(targetGroups, page.TargetGroups...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, targetGroup := range targetGroups {
+		output, err := elbClient.DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+			TargetGroupArn: targetGroup.TargetGroupArn,
+		})
+		if err != nil {
+			log.Printf("Error describing target health for target group %s: %v", aws.StringValue(targetGroup.TargetGroupName), err)
+			return nil, err
+		}
+		for _, description := range output.TargetHealthDescriptions {
+			targetStatuses = append(targetStatuses, ALBTargetStatus{
+				TargetGroup:  targetGroupDimension(aws.StringValue(targetGroup.TargetGroupArn)),
+				TargetId:     aws.StringValue(description.Target.Id),
+				Port:         aws.Int64Value(description.Target.Port),
+				TargetHealth: aws.StringValue(description.TargetHealth.State),
+				Reason:       aws.StringValue(description.TargetHealth.Reason),
+				Description:  aws.StringValue(description.TargetHealth.Description),
+			})
+		}
+	}
+	return targetStatuses, nil
+}
+
+func printTargetStatusTable(targetStatuses []ALBTargetStatus) string {
+	var buffer bytes.Buffer
+	if len(targetStatuses) == 0 {
+		buffer.WriteString("No target statuses found.")
+		return buffer.String()
+	}
+
+	table := tablewriter.NewWriter(&buffer)
+	table.SetHeader([]string{"Target Group", "Target ID", "Port", "Target Health", "Reason", "Description"})
+	for _, status := range targetStatuses {
+		table.Append([]string{
+			status.TargetGroup,
+			status.TargetId,
+			strconv.FormatInt(status.Port, 10),
+			status.TargetHealth,
+			status.Reason,
+			status.Description,
+		})
+	}
+	table.Render()
+	return buffer.String()
+}
+
+func init() {
+	AwsxALBTargetStatusCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxALBTargetStatusCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxALBTargetStatusCmd.PersistentFlags().String("query", "", "query")
+	AwsxALBTargetStatusCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxALBTargetStatusCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxALBTargetStatusCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxALBTargetStatusCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxALBTargetStatusCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxALBTargetStatusCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxALBTargetStatusCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxALBTargetStatusCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxALBTargetStatusCmd.PersistentFlags().String("instanceId", "", "load balancer dimension value. app/<name>/<id>")
+	AwsxALBTargetStatusCmd.PersistentFlags().String("loadBalancerArn", "", "ALB Load Balancer ARN")
+	AwsxALBTargetStatusCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,212 @@
+package Alarm
+
+import (
+	"encoding/json"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+type AlarmTransition struct {
+	AlarmName string    `json:"alarmName"`
+	Timestamp time.Time `json:"timestamp"`
+	OldState  string    `json:"oldState"`
+	NewState  string    `json:"newState"`
+	Summary   string    `json:"summary"`
+}
+
+type AlarmPeriod struct {
+	AlarmName string    `json:"alarmName"`
+	Start     time.Time `json:"start"`
+	End       time.Time `json:"end"`
+	// Duration is in seconds, like TimeInAlarm
+	Duration float64 `json:"duration"`
+	// StartedBefore is true when the alarm was already in ALARM at the start of the
+	// window, so Start is the window start rather than when the alarm fired
+	StartedBefore bool `json:"startedBefore"`
+	// Open is true when the alarm was still in ALARM at the end of the window
+	Open bool `json:"open"`
+}
+
+type AlarmTimeline struct {
+	Transitions []AlarmTransition `json:"transitions"`
+	// TimeInAlarm is the number of seconds each alarm spent in ALARM within the window
+	TimeInAlarm      map[string]float64 `json:"timeInAlarm"`
+	TotalTimeInAlarm float64            `json:"totalTimeInAlarm"`
+	Periods          []AlarmPeriod      `json:"periods"`
+}
+
+type stateHistoryData struct {
+	OldState struct {
+		StateValue string `json:"stateValue"`
+	} `json:"oldState"`
+	NewState struct {
+		StateValue string `json:"stateValue"`
+	} `json:"newState"`
+}
+
+// GetAlarmTimeline reads the state history of the given alarms and builds the
+// state-transition timeline and time spent in ALARM between startTime and endTime.
+// currentStates holds the present state of each alarm. It is only used for alarms
+// without any state change in their history up to endTime.
+func GetAlarmTimeline(clientAuth *model.Auth, currentStates map[string]string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*AlarmTimeline, error) {
+	if cloudWatchClient == nil {
+		cloudWatchClient = awsclient.GetClient(*clientAuth, awsclient.CLOUDWATCH).(*cloudwatch.CloudWatch)
+	}
+
+	timeline := &AlarmTimeline{
+		Transitions: []AlarmTransition{},
+		TimeInAlarm: map[string]float64{},
+		Periods:     []AlarmPeriod{},
+	}
+
+	var names []string
+	for name := range currentStates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		transitions, err := getAlarmTransitions(cloudWatchClient, name, startTime, endTime)
+		if err != nil {
+			log.Printf("Error describing alarm history for %s: %v", name, err)
+			return nil, err
+		}
+		timeline.Transitions = append(timeline.Transitions, transitions...)
+
+		startState := currentStates[name]
+		if len(transitions) > 0 {
+			startState = transitions[0].OldState
+		} else {
+			state, found, err := getAlarmStateAt(cloudWatchClient, name, startTime)
+			if err != nil {
+				log.Printf("Error describing alarm history for %s: %v", name, err)
+				return nil, err
+			}
+			if found {
+				startState = state
+			}
+		}
+
+		periods := BuildAlarmPeriods(name, transitions, startState, *startTime, *endTime)
+		total := 0.0
+		for _, period := range periods {
+			total += period.Duration
+		}
+		timeline.Periods = append(timeline.Periods, periods...)
+		timeline.TimeInAlarm[name] = total
+		timeline.TotalTimeInAlarm += total
+	}
+
+	sort.Slice(timeline.Transitions, func(i, j int) bool {
+		return timeline.Transitions[i].Timestamp.Before(timeline.Transitions[j].Timestamp)
+	})
+
+	return timeline, nil
+}
+
+// getAlarmStateAt returns the state the alarm was in at the given time, i.e. the new state
+// of its last transition before it. found is false when the history has no transition
+// before the time.
+func getAlarmStateAt(cloudWatchClient *cloudwatch.CloudWatch, alarmName string, at *time.Time) (string, bool, error) {
+	output, err := cloudWatchClient.DescribeAlarmHistory(&cloudwatch.DescribeAlarmHistoryInput{
+		AlarmName:       aws.String(alarmName),
+		AlarmTypes:      aws.StringSlice([]string{cloudwatch.AlarmTypeMetricAlarm, cloudwatch.AlarmTypeCompositeAlarm}),
+		HistoryItemType: aws.String(cloudwatch.HistoryItemTypeStateUpdate),
+		EndDate:         at,
+		ScanBy:          aws.String(cloudwatch.ScanByTimestampDescending),
+		MaxRecords:      aws.Int64(1),
+	})
+	if err != nil {
+		return "", false, err
+	}
+	if len(output.AlarmHistoryItems) == 0 {
+		return "", false, nil
+	}
+
+	var data stateHistoryData
+	if err := json.Unmarshal([]byte(aws.StringValue(output.AlarmHistoryItems[0].HistoryData)), &data); err != nil {
+		return "", false, err
+	}
+	return data.NewState.StateValue, true, nil
+}
+
+func getAlarmTransitions(cloudWatchClient *cloudwatch.CloudWatch, alarmName string, startTime, endTime *time.Time) ([]AlarmTransition, error) {
+	var transitions []AlarmTransition
+	input := &cloudwatch.DescribeAlarmHistoryInput{
+		AlarmName:       aws.String(alarmName),
+		AlarmTypes:      aws.StringSlice([]string{cloudwatch.AlarmTypeMetricAlarm, cloudwatch.AlarmTypeCompositeAlarm}),
+		HistoryItemType: aws.String(cloudwatch.HistoryItemTypeStateUpdate),
+		StartDate:       startTime,
+		EndDate:         endTime,
+		ScanBy:          aws.String(cloudwatch.ScanByTimestampAscending),
+	}
+	err := cloudWatchClient.DescribeAlarmHistoryPages(input, func(page *cloudwatch.DescribeAlarmHistoryOutput, lastPage bool) bool {
+		for _, item := range page.AlarmHistoryItems {
+			var data stateHistoryData
+			if err := json.Unmarshal([]byte(aws.StringValue(item.HistoryData)), &data); err != nil {
+				log.Printf("Error parsing alarm history data for %s: %v", alarmName, err)
+				continue
+			}
+			transitions = append(transitions, AlarmTransition{
+				AlarmName: alarmName,
+				Timestamp: aws.TimeValue(item.Timestamp),
+				OldState:  data.OldState.StateValue,
+				NewState:  data.NewState.StateValue,
+				Summary:   aws.StringValue(item.HistorySummary),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(transitions, func(i, j int) bool {
+		return transitions[i].Timestamp.Before(transitions[j].Timestamp)
+	})
+	return transitions, nil
+}
+
+// BuildAlarmPeriods returns the intervals the alarm spent in ALARM within the window,
+// given the transitions in the window and the state the alarm was in at startTime.
+func BuildAlarmPeriods(alarmName string, transitions []AlarmTransition, startState string, startTime, endTime time.Time) []AlarmPeriod {
+	var periods []AlarmPeriod
+
+	state := startState
+	since := startTime
+
+	for _, transition := range transitions {
+		if state == cloudwatch.StateValueAlarm && transition.NewState != cloudwatch.StateValueAlarm {
+			periods = append(periods, AlarmPeriod{
+				AlarmName:     alarmName,
+				Start:         since,
+				End:           transition.Timestamp,
+				Duration:      transition.Timestamp.Sub(since).Seconds(),
+				StartedBefore: len(periods) == 0 && startState == cloudwatch.StateValueAlarm,
+			})
+		}
+		if state != cloudwatch.StateValueAlarm && transition.NewState == cloudwatch.StateValueAlarm {
+			since = transition.Timestamp
+		}
+		state = transition.NewState
+	}
+
+	if state == cloudwatch.StateValueAlarm {
+		periods = append(periods, AlarmPeriod{
+			AlarmName:     alarmName,
+			Start:         since,
+			End:           endTime,
+			Duration:      endTime.Sub(since).Seconds(),
+			StartedBefore: len(periods) == 0 && startState == cloudwatch.StateValueAlarm,
+			Open:          true,
+		})
+	}
+
+	return periods
+}
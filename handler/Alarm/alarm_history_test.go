@@ -0,0 +1,68 @@
+package Alarm
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBuildAlarmPeriods(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	at := func(minutes int) time.Time { return start.Add(time.Duration(minutes) * time.Minute) }
+	transition := func(minutes int, oldState, newState string) AlarmTransition {
+		return AlarmTransition{AlarmName: "cpu", Timestamp: at(minutes), OldState: oldState, NewState: newState}
+	}
+
+	tests := []struct {
+		name        string
+		transitions []AlarmTransition
+		startState  string
+		want        []AlarmPeriod
+	}{
+		{
+			name:       "ok throughout",
+			startState: "OK",
+		},
+		{
+			name:       "alarm throughout",
+			startState: "ALARM",
+			want: []AlarmPeriod{
+				{AlarmName: "cpu", Start: start, End: end, Duration: 3600, StartedBefore: true, Open: true},
+			},
+		},
+		{
+			name:        "fires and recovers",
+			transitions: []AlarmTransition{transition(10, "OK", "ALARM"), transition(25, "ALARM", "OK")},
+			startState:  "OK",
+			want: []AlarmPeriod{
+				{AlarmName: "cpu", Start: at(10), End: at(25), Duration: 900},
+			},
+		},
+		{
+			name:        "recovers from before the window",
+			transitions: []AlarmTransition{transition(5, "ALARM", "OK"), transition(40, "OK", "ALARM")},
+			startState:  "ALARM",
+			want: []AlarmPeriod{
+				{AlarmName: "cpu", Start: start, End: at(5), Duration: 300, StartedBefore: true},
+				{AlarmName: "cpu", Start: at(40), End: end, Duration: 1200, Open: true},
+			},
+		},
+		{
+			name:        "insufficient data ends the period",
+			transitions: []AlarmTransition{transition(10, "OK", "ALARM"), transition(20, "ALARM", "INSUFFICIENT_DATA")},
+			startState:  "OK",
+			want: []AlarmPeriod{
+				{AlarmName: "cpu", Start: at(10), End: at(20), Duration: 600},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := BuildAlarmPeriods("cpu", test.transitions, test.startState, start, end)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("BuildAlarmPeriods = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package Alarm
+
+import (
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"time"
+)
+
+// elementDimensions maps element types to the CloudWatch dimension identifying the resource
+var elementDimensions = map[string]string{
+	"EC2":            "InstanceId",
+	"AWS/EC2":        "InstanceId",
+	"RDS":            "DBInstanceIdentifier",
+	"AWS/RDS":        "DBInstanceIdentifier",
+	"Lambda":         "FunctionName",
+	"AWS/Lambda":     "FunctionName",
+	"NLB":            "LoadBalancer",
+	"AWS/NLB":        "LoadBalancer",
+	"AWS/NetworkELB": "LoadBalancer",
+	"ECS":            "ClusterName",
+	"AWS/ECS":        "ClusterName",
+	"EKS":            "ClusterName",
+	"AWS/EKS":        "ClusterName",
+}
+
+// GetDimensionName returns the alarm dimension name used for the given element type.
+func GetDimensionName(elementType string) string {
+	return elementDimensions[elementType]
+}
+
+type ElementAlarms struct {
+	MetricAlarms    []*cloudwatch.MetricAlarm
+	CompositeAlarms []*cloudwatch.CompositeAlarm
+}
+
+// CurrentStates returns the present state of every alarm keyed by alarm name.
+func (e *ElementAlarms) CurrentStates() map[string]string {
+	states := map[string]string{}
+	for _, alarm := range e.MetricAlarms {
+		states[aws.StringValue(alarm.AlarmName)] = aws.StringValue(alarm.StateValue)
+	}
+	for _, alarm := range e.CompositeAlarms {
+		states[aws.StringValue(alarm.AlarmName)] = aws.StringValue(alarm.StateValue)
+	}
+	return states
+}
+
+// AlarmNotification is an alarm whose state changed within the panel window.
+type AlarmNotification struct {
+	Timestamp   time.Time
+	AlarmName   string
+	State       string
+	Alert       string
+	Description string
+}
+
+// Notifications returns the metric and composite alarms whose state was last updated
+// between startTime and endTime.
+func (e *ElementAlarms) Notifications(startTime, endTime time.Time) []AlarmNotification {
+	var notifications []AlarmNotification
+	for _, alarm := range e.MetricAlarms {
+		if isUpdatedWithin(alarm.StateUpdatedTimestamp, startTime, endTime) {
+			notifications = append(notifications, AlarmNotification{
+				Timestamp:   *alarm.StateUpdatedTimestamp,
+				AlarmName:   aws.StringValue(alarm.AlarmName),
+				State:       aws.StringValue(alarm.StateValue),
+				Alert:       aws.StringValue(alarm.StateReason),
+				Description: aws.StringValue(alarm.AlarmDescription),
+			})
+		}
+	}
+	for _, alarm := range e.CompositeAlarms {
+		if isUpdatedWithin(alarm.StateUpdatedTimestamp, startTime, endTime) {
+			notifications = append(notifications, AlarmNotification{
+				Timestamp:   *alarm.StateUpdatedTimestamp,
+				AlarmName:   aws.StringValue(alarm.AlarmName),
+				State:       aws.StringValue(alarm.StateValue),
+				Alert:       aws.StringValue(alarm.StateReason),
+				Description: aws.StringValue(alarm.AlarmDescription),
+			})
+		}
+	}
+	return notifications
+}
+
+func isUpdatedWithin(stateUpdatedTime *time.Time, startTime, endTime time.Time) bool {
+	return stateUpdatedTime != nil && stateUpdatedTime.After(startTime) && stateUpdatedTime.Before(endTime)
+}
+
+// GetElementAlarms returns the metric alarms watching the given resource and the
+// composite alarms whose rule references any of them.
+func GetElementAlarms(clientAuth *model.Auth, dimensionName, dimensionValue string, cloudWatchClient *cloudwatch.CloudWatch) (*ElementAlarms, error) {
+	if cloudWatchClient == nil {
+		cloudWatchClient = awsclient.GetClient(*clientAuth, awsclient.CLOUDWATCH).(*cloudwatch.CloudWatch)
+	}
+
+	var metricAlarms []*cloudwatch.MetricAlarm
+	var compositeAlarms []*cloudwatch.CompositeAlarm
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmTypes: aws.StringSlice([]string{cloudwatch.AlarmTypeMetricAlarm, cloudwatch.AlarmTypeCompositeAlarm}),
+	}
+	err := cloudWatchClient.DescribeAlarmsPages(input, func(page *cloudwatch.DescribeAlarmsOutput, lastPage bool) bool {
+		metricAlarms = append(metricAlarms, page.MetricAlarms...)
+		compositeAlarms = append(compositeAlarms, page.CompositeAlarms...)
+		return true
+	})
+	if err != nil {
+		log.Println("Error describing alarms:", err)
+		return nil, err
+	}
+
+	result := &ElementAlarms{}
+	for _, alarm := range metricAlarms {
+		if alarmMatchesDimension(alarm, dimensionName, dimensionValue) {
+			result.MetricAlarms = append(result.MetricAlarms, alarm)
+		}
+	}
+
+	children := map[string]bool{}
+	for _, child := range result.MetricAlarms {
+		children[aws.StringValue(child.AlarmName)] = true
+		children[aws.StringValue(child.AlarmArn)] = true
+	}
+	for _, alarm := range compositeAlarms {
+		for _, reference := range alarmRuleReferences(aws.StringValue(alarm.AlarmRule)) {
+			if children[reference] {
+				result.CompositeAlarms = append(result.CompositeAlarms, alarm)
+				break
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// alarmRuleFunction matches the ALARM, OK and INSUFFICIENT_DATA functions of a composite
+// alarm rule. The argument is an alarm name or ARN, either quoted or not.
+var alarmRuleFunction = regexp.MustCompile(`\b(?:ALARM|OK|INSUFFICIENT_DATA)\(\s*(?:"([^"]*)"|([^\s()]+))\s*\)`)
+
+// alarmRuleReferences returns the alarm names and ARNs the composite alarm rule references.
+func alarmRuleReferences(rule string) []string {
+	var references []string
+	for _, match := range alarmRuleFunction.FindAllStringSubmatch(rule, -1) {
+		reference := match[1]
+		if reference == "" {
+			reference = match[2]
+		}
+		references = append(references, strings.TrimSpace(reference))
+	}
+	return references
+}
+
+func alarmMatchesDimension(alarm *cloudwatch.MetricAlarm, dimensionName, dimensionValue string) bool {
+	if dimensionsMatch(alarm.Dimensions, dimensionName, dimensionValue) {
+		return true
+	}
+	// Metric math alarms keep their dimensions on the individual queries
+	for _, query := range alarm.Metrics {
+		if query.MetricStat != nil && query.MetricStat.Metric != nil && dimensionsMatch(query.MetricStat.Metric.Dimensions, dimensionName, dimensionValue) {
+			return true
+		}
+	}
+	return false
+}
+
+func dimensionsMatch(dimensions []*cloudwatch.Dimension, dimensionName, dimensionValue string) bool {
+	for _, dimension := range dimensions {
+		if aws.StringValue(dimension.Name) == dimensionName && aws.StringValue(dimension.Value) == dimensionValue {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,334 @@
+package Alarm
+
+import (
+	"encoding/json"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/cmdb"
+	"github.com/Appkube-awsx/awsx-common/config"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+// HealthMetric describes a metric series whose failing datapoints mark an outage,
+// such as StatusCheckFailed or cluster_failed_node_count.
+type HealthMetric struct {
+	Namespace  string
+	MetricName string
+	Dimensions map[string]string
+	Stat       string
+	Period     int64
+	// Failing reports whether a datapoint belongs to an outage
+	Failing func(value float64) bool
+}
+
+type Incident struct {
+	Source     string    `json:"source"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+	DetectedAt time.Time `json:"detectedAt"`
+	// Durations are in seconds
+	Duration       float64 `json:"duration"`
+	DetectionDelay float64 `json:"detectionDelay"`
+	Open           bool    `json:"open"`
+}
+
+type RecoveryStats struct {
+	// IncidentSource names what the incidents were detected from: the health
+	// metric as namespace/metric name, or "alarms" when the alarm periods were used
+	IncidentSource string `json:"incidentSource"`
+	IncidentCount  int    `json:"incidentCount"`
+	// MTTR, MTTD and LongestOutage are in seconds
+	MTTR          float64    `json:"mttr"`
+	MTTD          float64    `json:"mttd"`
+	LongestOutage float64    `json:"longestOutage"`
+	Incidents     []Incident `json:"incidents"`
+}
+
+// defaultRecoveryRange is the default window of the MTTR panels. Incidents are rare, so
+// it reaches further back than the metric panels do.
+const defaultRecoveryRange = 24 * time.Hour
+
+// GetMeanTimeToRecoveryPanel resolves the element from the elementId or instanceId flag
+// and reports its recovery figures over the startTime and endTime flags. healthMetric
+// returns the health series of the resolved instance id; it is nil for element types
+// without one, whose incidents come from their alarms.
+func GetMeanTimeToRecoveryPanel(cmd *cobra.Command, clientAuth *model.Auth, elementType string, healthMetric func(instanceId string) *HealthMetric, cloudWatchClient *cloudwatch.CloudWatch) (string, *RecoveryStats, error) {
+	elementId, _ := cmd.PersistentFlags().GetString("elementId")
+	cmdbApiUrl, _ := cmd.PersistentFlags().GetString("cmdbApiUrl")
+	instanceId, _ := cmd.PersistentFlags().GetString("instanceId")
+
+	if elementId != "" {
+		log.Println("getting cloud-element data from cmdb")
+		apiUrl := cmdbApiUrl
+		if cmdbApiUrl == "" {
+			log.Println("using default cmdb url")
+			apiUrl = config.CmdbUrl
+		}
+		log.Println("cmdb url: " + apiUrl)
+		cmdbData, err := cmdb.GetCloudElementData(apiUrl, elementId)
+		if err != nil {
+			return "", nil, err
+		}
+		instanceId = cmdbData.InstanceId
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, defaultRecoveryRange)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var metric *HealthMetric
+	if healthMetric != nil {
+		metric = healthMetric(instanceId)
+	}
+	return GetMeanTimeToRecovery(clientAuth, GetDimensionName(elementType), instanceId, metric, startTime, endTime, cloudWatchClient)
+}
+
+// GetMeanTimeToRecovery detects incidents for the resource identified by dimensionName
+// and dimensionValue and reports MTTR, MTTD, incident count and longest outage.
+// Incidents come from healthMetric when given, otherwise from the alarms on the resource.
+func GetMeanTimeToRecovery(clientAuth *model.Auth, dimensionName, dimensionValue string, healthMetric *HealthMetric, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (string, *RecoveryStats, error) {
+	if cloudWatchClient == nil {
+		cloudWatchClient = awsclient.GetClient(*clientAuth, awsclient.CLOUDWATCH).(*cloudwatch.CloudWatch)
+	}
+
+	elementAlarms, err := GetElementAlarms(clientAuth, dimensionName, dimensionValue, cloudWatchClient)
+	if err != nil {
+		return "", nil, err
+	}
+	timeline, err := GetAlarmTimeline(clientAuth, elementAlarms.CurrentStates(), startTime, endTime, cloudWatchClient)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var metricIncidents []Incident
+	if healthMetric != nil {
+		metricIncidents, err = getMetricIncidents(cloudWatchClient, healthMetric, startTime, endTime)
+		if err != nil {
+			log.Println("Error getting health metric data: ", err)
+			return "", nil, err
+		}
+	}
+
+	stats := CalculateRecoveryStats(metricIncidents, timeline.Periods, alarmDetectionLags(elementAlarms))
+	stats.IncidentSource = "alarms"
+	if len(metricIncidents) > 0 {
+		stats.IncidentSource = healthMetric.Namespace + "/" + healthMetric.MetricName
+	}
+
+	jsonString, err := json.Marshal(stats)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+	return string(jsonString), stats, nil
+}
+
+func getMetricIncidents(cloudWatchClient *cloudwatch.CloudWatch, healthMetric *HealthMetric, startTime, endTime *time.Time) ([]Incident, error) {
+	var dimensions []*cloudwatch.Dimension
+	for name, value := range healthMetric.Dimensions {
+		dimensions = append(dimensions, &cloudwatch.Dimension{
+			Name:  aws.String(name),
+			Value: aws.String(value),
+		})
+	}
+
+	input := &cloudwatch.GetMetricDataInput{
+		EndTime:   endTime,
+		StartTime: startTime,
+		ScanBy:    aws.String(cloudwatch.ScanByTimestampAscending),
+		MetricDataQueries: []*cloudwatch.MetricDataQuery{
+			{
+				Id: aws.String("m1"),
+				MetricStat: &cloudwatch.MetricStat{
+					Metric: &cloudwatch.Metric{
+						Dimensions: dimensions,
+						MetricName: aws.String(healthMetric.MetricName),
+						Namespace:  aws.String(healthMetric.Namespace),
+					},
+					Period: aws.Int64(healthMetric.Period),
+					Stat:   aws.String(healthMetric.Stat),
+				},
+			},
+		},
+	}
+
+	var timestamps []time.Time
+	var values []float64
+	err := cloudWatchClient.GetMetricDataPages(input, func(page *cloudwatch.GetMetricDataOutput, lastPage bool) bool {
+		for _, result := range page.MetricDataResults {
+			for i := range result.Timestamps {
+				timestamps = append(timestamps, *result.Timestamps[i])
+				values = append(values, *result.Values[i])
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return DetectIncidents(healthMetric.MetricName, timestamps, values, healthMetric.Failing, time.Duration(healthMetric.Period)*time.Second, *endTime), nil
+}
+
+// DetectIncidents groups consecutive failing datapoints into incidents. An incident
+// ends at the first healthy datapoint, or stays open when the series ends failing.
+func DetectIncidents(source string, timestamps []time.Time, values []float64, failing func(float64) bool, period time.Duration, endTime time.Time) []Incident {
+	type point struct {
+		timestamp time.Time
+		value     float64
+	}
+	points := make([]point, len(timestamps))
+	for i := range timestamps {
+		points[i] = point{timestamps[i], values[i]}
+	}
+	sort.Slice(points, func(i, j int) bool { return points[i].timestamp.Before(points[j].timestamp) })
+
+	var incidents []Incident
+	var current *Incident
+	var lastFailing time.Time
+	for _, p := range points {
+		if failing(p.value) {
+			if current == nil {
+				current = &Incident{Source: source, Start: p.timestamp}
+			}
+			lastFailing = p.timestamp
+			continue
+		}
+		if current != nil {
+			current.End = p.timestamp
+			incidents = append(incidents, *current)
+			current = nil
+		}
+	}
+	if current != nil {
+		end := lastFailing.Add(period)
+		if end.After(endTime) {
+			end = endTime
+		}
+		current.End = end
+		current.Open = true
+		incidents = append(incidents, *current)
+	}
+
+	for i := range incidents {
+		incidents[i].Duration = incidents[i].End.Sub(incidents[i].Start).Seconds()
+	}
+	return incidents
+}
+
+// CalculateRecoveryStats derives the recovery figures. Metric incidents are detected
+// when the first overlapping alarm fired. Without metric incidents the alarm periods
+// themselves are the incidents, starting one detection lag before the alarm fired.
+// Alarms already in ALARM at the window start fired at an unknown time, so they
+// detect nothing.
+func CalculateRecoveryStats(metricIncidents []Incident, alarmPeriods []AlarmPeriod, detectionLags map[string]time.Duration) *RecoveryStats {
+	incidents := metricIncidents
+	if len(incidents) == 0 {
+		incidents = alarmIncidents(alarmPeriods, detectionLags)
+	} else {
+		for i := range incidents {
+			for _, period := range alarmPeriods {
+				if period.StartedBefore {
+					continue
+				}
+				if period.Start.Before(incidents[i].End) && !period.End.Before(incidents[i].Start) {
+					if incidents[i].DetectedAt.IsZero() || period.Start.Before(incidents[i].DetectedAt) {
+						incidents[i].DetectedAt = period.Start
+					}
+				}
+			}
+			if !incidents[i].DetectedAt.IsZero() && incidents[i].DetectedAt.After(incidents[i].Start) {
+				incidents[i].DetectionDelay = incidents[i].DetectedAt.Sub(incidents[i].Start).Seconds()
+			}
+		}
+	}
+
+	stats := &RecoveryStats{
+		IncidentCount: len(incidents),
+		Incidents:     incidents,
+	}
+	if stats.Incidents == nil {
+		stats.Incidents = []Incident{}
+	}
+
+	detected := 0
+	for _, incident := range incidents {
+		stats.MTTR += incident.Duration
+		if incident.Duration > stats.LongestOutage {
+			stats.LongestOutage = incident.Duration
+		}
+		if !incident.DetectedAt.IsZero() {
+			stats.MTTD += incident.DetectionDelay
+			detected++
+		}
+	}
+	if len(incidents) > 0 {
+		stats.MTTR /= float64(len(incidents))
+	}
+	if detected > 0 {
+		stats.MTTD /= float64(detected)
+	}
+	return stats
+}
+
+// alarmIncidents merges overlapping alarm periods into incidents. A period that began
+// before the window keeps the window start and has no detection time.
+func alarmIncidents(alarmPeriods []AlarmPeriod, detectionLags map[string]time.Duration) []Incident {
+	periods := append([]AlarmPeriod{}, alarmPeriods...)
+	sort.Slice(periods, func(i, j int) bool { return periods[i].Start.Before(periods[j].Start) })
+
+	var incidents []Incident
+	for _, period := range periods {
+		var lag time.Duration
+		detectedAt := period.Start
+		if period.StartedBefore {
+			detectedAt = time.Time{}
+		} else {
+			lag = detectionLags[period.AlarmName]
+		}
+		start := period.Start.Add(-lag)
+		if n := len(incidents); n > 0 && !start.After(incidents[n-1].End) {
+			last := &incidents[n-1]
+			if period.End.After(last.End) {
+				last.End = period.End
+				last.Open = period.Open
+			}
+			continue
+		}
+		incidents = append(incidents, Incident{
+			Source:         period.AlarmName,
+			Start:          start,
+			End:            period.End,
+			DetectedAt:     detectedAt,
+			DetectionDelay: lag.Seconds(),
+			Open:           period.Open,
+		})
+	}
+
+	for i := range incidents {
+		incidents[i].Duration = incidents[i].End.Sub(incidents[i].Start).Seconds()
+	}
+	return incidents
+}
+
+// alarmDetectionLags estimates how long each metric alarm needs to fire after the
+// first breaching datapoint, from its period and datapoints to alarm.
+func alarmDetectionLags(elementAlarms *ElementAlarms) map[string]time.Duration {
+	lags := map[string]time.Duration{}
+	for _, alarm := range elementAlarms.MetricAlarms {
+		datapoints := aws.Int64Value(alarm.DatapointsToAlarm)
+		if datapoints == 0 {
+			datapoints = aws.Int64Value(alarm.EvaluationPeriods)
+		}
+		lags[aws.StringValue(alarm.AlarmName)] = time.Duration(datapoints*aws.Int64Value(alarm.Period)) * time.Second
+	}
+	return lags
+}
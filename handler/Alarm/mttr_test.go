@@ -0,0 +1,191 @@
+package Alarm
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+var windowStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func at(minutes int) time.Time {
+	return windowStart.Add(time.Duration(minutes) * time.Minute)
+}
+
+func TestDetectIncidents(t *testing.T) {
+	failing := func(value float64) bool { return value > 0 }
+	period := 5 * time.Minute
+
+	tests := []struct {
+		name       string
+		timestamps []time.Time
+		values     []float64
+		endTime    time.Time
+		want       []Incident
+	}{
+		{
+			name:       "healthy",
+			timestamps: []time.Time{at(0), at(5)},
+			values:     []float64{0, 0},
+			endTime:    at(10),
+		},
+		{
+			name:       "closed by a healthy datapoint",
+			timestamps: []time.Time{at(0), at(5), at(10), at(15)},
+			values:     []float64{0, 1, 1, 0},
+			endTime:    at(20),
+			want: []Incident{
+				{Source: "m", Start: at(5), End: at(15), Duration: 600},
+			},
+		},
+		{
+			name:       "unsorted datapoints",
+			timestamps: []time.Time{at(15), at(5), at(0), at(10)},
+			values:     []float64{0, 1, 0, 1},
+			endTime:    at(20),
+			want: []Incident{
+				{Source: "m", Start: at(5), End: at(15), Duration: 600},
+			},
+		},
+		{
+			name:       "open at the end of the series",
+			timestamps: []time.Time{at(0), at(5)},
+			values:     []float64{0, 1},
+			endTime:    at(30),
+			want: []Incident{
+				{Source: "m", Start: at(5), End: at(10), Duration: 300, Open: true},
+			},
+		},
+		{
+			name:       "open incident clipped to the end time",
+			timestamps: []time.Time{at(0), at(5)},
+			values:     []float64{0, 1},
+			endTime:    at(7),
+			want: []Incident{
+				{Source: "m", Start: at(5), End: at(7), Duration: 120, Open: true},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := DetectIncidents("m", test.timestamps, test.values, failing, period, test.endTime)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("DetectIncidents = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAlarmIncidents(t *testing.T) {
+	lags := map[string]time.Duration{"cpu": 3 * time.Minute, "disk": 10 * time.Minute}
+
+	tests := []struct {
+		name    string
+		periods []AlarmPeriod
+		want    []Incident
+	}{
+		{
+			name:    "lag moves the start before the alarm",
+			periods: []AlarmPeriod{{AlarmName: "cpu", Start: at(10), End: at(20)}},
+			want: []Incident{
+				{Source: "cpu", Start: at(7), End: at(20), DetectedAt: at(10), Duration: 780, DetectionDelay: 180},
+			},
+		},
+		{
+			name:    "period from before the window keeps the window start",
+			periods: []AlarmPeriod{{AlarmName: "cpu", Start: windowStart, End: at(20), StartedBefore: true}},
+			want: []Incident{
+				{Source: "cpu", Start: windowStart, End: at(20), Duration: 1200},
+			},
+		},
+		{
+			name: "overlapping periods merge",
+			periods: []AlarmPeriod{
+				{AlarmName: "disk", Start: at(25), End: at(40), Open: true},
+				{AlarmName: "cpu", Start: at(10), End: at(20)},
+			},
+			want: []Incident{
+				{Source: "cpu", Start: at(7), End: at(40), DetectedAt: at(10), Duration: 1980, DetectionDelay: 180, Open: true},
+			},
+		},
+		{
+			name: "separate periods stay separate",
+			periods: []AlarmPeriod{
+				{AlarmName: "cpu", Start: at(10), End: at(20)},
+				{AlarmName: "cpu", Start: at(30), End: at(35)},
+			},
+			want: []Incident{
+				{Source: "cpu", Start: at(7), End: at(20), DetectedAt: at(10), Duration: 780, DetectionDelay: 180},
+				{Source: "cpu", Start: at(27), End: at(35), DetectedAt: at(30), Duration: 480, DetectionDelay: 180},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := alarmIncidents(test.periods, lags)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("alarmIncidents = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCalculateRecoveryStats(t *testing.T) {
+	t.Run("no incidents", func(t *testing.T) {
+		stats := CalculateRecoveryStats(nil, nil, nil)
+		want := &RecoveryStats{Incidents: []Incident{}}
+		if !reflect.DeepEqual(stats, want) {
+			t.Errorf("CalculateRecoveryStats = %+v, want %+v", stats, want)
+		}
+	})
+
+	t.Run("metric incidents detected by alarms", func(t *testing.T) {
+		metricIncidents := []Incident{
+			{Source: "m", Start: at(10), End: at(20), Duration: 600},
+			{Source: "m", Start: at(30), End: at(50), Duration: 1200},
+		}
+		periods := []AlarmPeriod{
+			// already firing at the window start, so it did not detect the first incident
+			{AlarmName: "disk", Start: windowStart, End: at(15), StartedBefore: true},
+			{AlarmName: "cpu", Start: at(14), End: at(18)},
+			{AlarmName: "cpu", Start: at(12), End: at(13)},
+		}
+		stats := CalculateRecoveryStats(metricIncidents, periods, nil)
+
+		if stats.IncidentCount != 2 || stats.MTTR != 900 || stats.LongestOutage != 1200 {
+			t.Errorf("count, MTTR, longest = %d, %v, %v, want 2, 900, 1200", stats.IncidentCount, stats.MTTR, stats.LongestOutage)
+		}
+		if !stats.Incidents[0].DetectedAt.Equal(at(12)) || stats.Incidents[0].DetectionDelay != 120 {
+			t.Errorf("first incident detected at %v after %vs, want %v after 120s", stats.Incidents[0].DetectedAt, stats.Incidents[0].DetectionDelay, at(12))
+		}
+		if !stats.Incidents[1].DetectedAt.IsZero() {
+			t.Errorf("second incident detected at %v, want undetected", stats.Incidents[1].DetectedAt)
+		}
+		// only the detected incident counts towards MTTD
+		if stats.MTTD != 120 {
+			t.Errorf("MTTD = %v, want 120", stats.MTTD)
+		}
+	})
+
+	t.Run("alarm periods as incidents", func(t *testing.T) {
+		periods := []AlarmPeriod{
+			{AlarmName: "cpu", Start: windowStart, End: at(10), StartedBefore: true},
+			{AlarmName: "cpu", Start: at(30), End: at(40)},
+		}
+		stats := CalculateRecoveryStats(nil, periods, map[string]time.Duration{"cpu": 2 * time.Minute})
+
+		if stats.IncidentCount != 2 {
+			t.Fatalf("IncidentCount = %d, want 2", stats.IncidentCount)
+		}
+		if !stats.Incidents[0].Start.Equal(windowStart) {
+			t.Errorf("first incident starts at %v, want the window start", stats.Incidents[0].Start)
+		}
+		// (600 + 720) / 2
+		if stats.MTTR != 660 || stats.LongestOutage != 720 {
+			t.Errorf("MTTR, longest = %v, %v, want 660, 720", stats.MTTR, stats.LongestOutage)
+		}
+		if stats.MTTD != 120 {
+			t.Errorf("MTTD = %v, want 120", stats.MTTD)
+		}
+	})
+}
@@ -7,9 +7,9 @@ import (
 	"time"
 
 	"github.com/Appkube-awsx/awsx-common/authenticate"
-	"github.com/Appkube-awsx/awsx-common/awsclient"
 	"github.com/Appkube-awsx/awsx-common/model"
-	"github.com/aws/aws-sdk-go/aws"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Statistic"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/spf13/cobra"
 )
@@ -58,6 +58,11 @@ func GetApiIntegrationLatencyData(cmd *cobra.Command, clientAuth *model.Auth, cl
 	startTimeStr, _ := cmd.PersistentFlags().GetString("startTime")
 	endTimeStr, _ := cmd.PersistentFlags().GetString("endTime")
 
+	statistics, err := Statistic.GetStatistics(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
 	var startTime, endTime *time.Time
 
 	// Parse start time if provided
@@ -88,17 +93,34 @@ func GetApiIntegrationLatencyData(cmd *cobra.Command, clientAuth *model.Auth, cl
 	// Debug prints
 	log.Printf("StartTime: %v, EndTime: %v", startTime, endTime)
 
-	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
+	if statistics != nil {
+		rawData, cloudwatchMetricData, err := GetApiIntegrationLatencyMetricValue(clientAuth, ApiName, startTime, endTime, statistics, cloudWatchClient)
+		if err != nil {
+			log.Println("Error in getting latency metric value: ", err)
+			return "", nil, err
+		}
+		results := map[string]ApiIntegrationLatencyResult{}
+		for statistic, points := range rawData {
+			results[statistic] = processIntegrationLatencyRawData(points)
+		}
+		jsonString, err := json.Marshal(results)
+		if err != nil {
+			log.Println("Error in marshalling json in string: ", err)
+			return "", nil, err
+		}
+		return string(jsonString), cloudwatchMetricData, nil
+	}
 
 	// Fetch raw data
-	metricValue, err := GetApiIntegrationLatencyMetricValue(clientAuth, startTime, endTime, ApiName, "Average", cloudWatchClient)
+	rawData, metricData, err := GetApiIntegrationLatencyMetricValue(clientAuth, ApiName, startTime, endTime, []string{"Average"}, cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting latency metric value: ", err)
 		return "", nil, err
 	}
-	cloudwatchMetricData["IntegrationLatency"] = metricValue
-
-	result := processIntegrationLatencyRawData(metricValue)
+	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{
+		"IntegrationLatency": metricData["Average"],
+	}
+	result := processIntegrationLatencyRawData(rawData["Average"])
 
 	jsonString, err := json.Marshal(result)
 	if err != nil {
@@ -109,55 +131,27 @@ func GetApiIntegrationLatencyData(cmd *cobra.Command, clientAuth *model.Auth, cl
 	return string(jsonString), cloudwatchMetricData, nil
 }
 
-func GetApiIntegrationLatencyMetricValue(clientAuth *model.Auth, startTime, endTime *time.Time, ApiName string, statistic string, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
-	input := &cloudwatch.GetMetricDataInput{
-		MetricDataQueries: []*cloudwatch.MetricDataQuery{
-			{
-				Id: aws.String("integration_latency"),
-				MetricStat: &cloudwatch.MetricStat{
-					Metric: &cloudwatch.Metric{
-						Namespace:  aws.String("AWS/ApiGateway"),
-						MetricName: aws.String("IntegrationLatency"),
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ApiName"),
-								Value: aws.String(ApiName),
-							},
-						},
-					},
-					Period: aws.Int64(300),
-					Stat:   aws.String("Average"),
-				},
-				ReturnData: aws.Bool(true),
-			},
-		},
-		StartTime: startTime,
-		EndTime:   endTime,
+// GetApiIntegrationLatencyMetricValue returns IntegrationLatency of the API for every
+// statistic, keyed by statistic, in a single request.
+func GetApiIntegrationLatencyMetricValue(clientAuth *model.Auth, ApiName string, startTime, endTime *time.Time, statistics []string, cloudWatchClient *cloudwatch.CloudWatch) (map[string][]Metric.DataPoint, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	var series []Metric.Series
+	for _, statistic := range statistics {
+		series = append(series, Metric.Series{Label: statistic, MetricName: "IntegrationLatency", Statistic: statistic})
 	}
-
-	if cloudWatchClient == nil {
-		cloudWatchClient = awsclient.GetClient(*clientAuth, awsclient.CLOUDWATCH).(*cloudwatch.CloudWatch)
-	}
-
-	result, err := cloudWatchClient.GetMetricData(input)
-	if err != nil {
-		return nil, err
-	}
-
-	return result, nil
+	return Metric.GetMetricData(clientAuth, "AWS/ApiGateway", 300, Metric.Dimensions("ApiName", ApiName), series, startTime, endTime, cloudWatchClient)
 }
-func processIntegrationLatencyRawData(result *cloudwatch.GetMetricDataOutput) ApiIntegrationLatencyResult {
+
+func processIntegrationLatencyRawData(points []Metric.DataPoint) ApiIntegrationLatencyResult {
 	var rawData ApiIntegrationLatencyResult
 	rawData.RawData = make([]struct {
 		Timestamp time.Time
 		Value     float64
-	}, len(result.MetricDataResults[0].Timestamps))
+	}, len(points))
 
-	for i, timestamp := range result.MetricDataResults[0].Timestamps {
-		rawData.RawData[i].Timestamp = *timestamp
-		rawData.RawData[i].Value = *result.MetricDataResults[0].Values[i]
+	for i, point := range points {
+		rawData.RawData[i].Timestamp = point.Timestamp
+		rawData.RawData[i].Value = point.Value
 	}
-
 	return rawData
 }
 
@@ -179,4 +173,5 @@ func init() {
 	AwsxApiIntegrationLatencyCmd.PersistentFlags().String("endTime", "", "end time")
 	AwsxApiIntegrationLatencyCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
 	AwsxApiIntegrationLatencyCmd.PersistentFlags().String("ApiName", "", "api name")
+	AwsxApiIntegrationLatencyCmd.PersistentFlags().String("stat", "", "comma separated statistics, e.g. p50,p90,p99,TM90,IQM")
 }
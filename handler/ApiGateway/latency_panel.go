@@ -7,9 +7,9 @@ import (
 	"time"
 
 	"github.com/Appkube-awsx/awsx-common/authenticate"
-	"github.com/Appkube-awsx/awsx-common/awsclient"
 	"github.com/Appkube-awsx/awsx-common/model"
-	"github.com/aws/aws-sdk-go/aws"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Statistic"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/spf13/cobra"
 )
@@ -58,6 +58,11 @@ func GetApiLatencyData(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchCli
 	startTimeStr, _ := cmd.PersistentFlags().GetString("startTime")
 	endTimeStr, _ := cmd.PersistentFlags().GetString("endTime")
 
+	statistics, err := Statistic.GetStatistics(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
 	var startTime, endTime *time.Time
 
 	// Parse start time if provided
@@ -88,18 +93,34 @@ func GetApiLatencyData(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchCli
 	// Debug prints
 	log.Printf("StartTime: %v, EndTime: %v", startTime, endTime)
 
-	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
+	if statistics != nil {
+		rawData, cloudwatchMetricData, err := GetApiLatencyMetricValue(clientAuth, ApiName, startTime, endTime, statistics, cloudWatchClient)
+		if err != nil {
+			log.Println("Error in getting latency metric value: ", err)
+			return "", nil, err
+		}
+		results := map[string]ApiLatency{}
+		for statistic, points := range rawData {
+			results[statistic] = processLatencyRawData(points)
+		}
+		jsonString, err := json.Marshal(results)
+		if err != nil {
+			log.Println("Error in marshalling json in string: ", err)
+			return "", nil, err
+		}
+		return string(jsonString), cloudwatchMetricData, nil
+	}
 
 	// Fetch raw data
-	metricValue, err := GetApiLatencyMetricValue(clientAuth, ApiName, startTime, endTime, "Sum", cloudWatchClient)
+	rawData, metricData, err := GetApiLatencyMetricValue(clientAuth, ApiName, startTime, endTime, []string{"Sum"}, cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting latency metric value: ", err)
 		return "", nil, err
 	}
-	cloudwatchMetricData["Latency"] = metricValue
-	result := processLatencyRawData(metricValue)
-	// Debug prints
-	//log.Printf("Latency Metric Value: %f", metricValue)
+	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{
+		"Latency": metricData["Sum"],
+	}
+	result := processLatencyRawData(rawData["Sum"])
 
 	jsonString, err := json.Marshal(result)
 	if err != nil {
@@ -110,53 +131,26 @@ func GetApiLatencyData(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchCli
 	return string(jsonString), cloudwatchMetricData, nil
 }
 
-func GetApiLatencyMetricValue(clientAuth *model.Auth, ApiName string, startTime, endTime *time.Time, statistic string, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
-	input := &cloudwatch.GetMetricDataInput{
-		MetricDataQueries: []*cloudwatch.MetricDataQuery{
-			{
-				Id: aws.String("latency"),
-				MetricStat: &cloudwatch.MetricStat{
-					Metric: &cloudwatch.Metric{
-						Namespace:  aws.String("AWS/ApiGateway"),
-						MetricName: aws.String("Latency"),
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ApiName"),
-								Value: aws.String(ApiName),
-							},
-						},
-					},
-					Period: aws.Int64(300), 
-					Stat:   aws.String("Sum"),
-				},
-				ReturnData: aws.Bool(true),
-			},
-		},
-		StartTime: startTime,
-		EndTime:   endTime,
-	}
-
-	if cloudWatchClient == nil {
-		cloudWatchClient = awsclient.GetClient(*clientAuth, awsclient.CLOUDWATCH).(*cloudwatch.CloudWatch)
-	}
-
-	result, err := cloudWatchClient.GetMetricData(input)
-	if err != nil {
-		return nil, err
+// GetApiLatencyMetricValue returns Latency of the API for every statistic, keyed by
+// statistic, in a single request.
+func GetApiLatencyMetricValue(clientAuth *model.Auth, ApiName string, startTime, endTime *time.Time, statistics []string, cloudWatchClient *cloudwatch.CloudWatch) (map[string][]Metric.DataPoint, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	var series []Metric.Series
+	for _, statistic := range statistics {
+		series = append(series, Metric.Series{Label: statistic, MetricName: "Latency", Statistic: statistic})
 	}
-     return result, nil
+	return Metric.GetMetricData(clientAuth, "AWS/ApiGateway", 300, Metric.Dimensions("ApiName", ApiName), series, startTime, endTime, cloudWatchClient)
 }
 
-func processLatencyRawData(result *cloudwatch.GetMetricDataOutput) ApiLatency {
+func processLatencyRawData(points []Metric.DataPoint) ApiLatency {
 	var rawData ApiLatency
 	rawData.RawData = make([]struct {
 		Timestamp time.Time
 		Value     float64
-	}, len(result.MetricDataResults[0].Timestamps))
+	}, len(points))
 
-	for i, timestamp := range result.MetricDataResults[0].Timestamps {
-		rawData.RawData[i].Timestamp = *timestamp
-		rawData.RawData[i].Value = *result.MetricDataResults[0].Values[i]
+	for i, point := range points {
+		rawData.RawData[i].Timestamp = point.Timestamp
+		rawData.RawData[i].Value = point.Value
 	}
 	return rawData
 }
@@ -179,4 +173,5 @@ func init() {
 	AwsxApiLatencyCmd.PersistentFlags().String("endTime", "", "end time")
 	AwsxApiLatencyCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
 	AwsxApiLatencyCmd.PersistentFlags().String("ApiName", "", "api name")
+	AwsxApiLatencyCmd.PersistentFlags().String("stat", "", "comma separated statistics, e.g. p50,p90,p99,TM90,IQM")
 }
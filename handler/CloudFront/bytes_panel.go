@@ -0,0 +1,74 @@
+package CloudFront
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var bytesSeries = []Metric.Series{
+	{Label: "BytesDownloaded", MetricName: "BytesDownloaded", Statistic: "Sum"},
+	{Label: "BytesUploaded", MetricName: "BytesUploaded", Statistic: "Sum"},
+}
+
+var AwsxCloudFrontBytesCmd = &cobra.Command{
+	Use:   "cloudfront_bytes_panel",
+	Short: "get bytes downloaded and uploaded of the distribution",
+	Long:  `command to get the bytes viewers downloaded from and uploaded to the distribution`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetCloudFrontBytesPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting cloudfront bytes: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetCloudFrontBytesPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetCloudFrontDistributionPanel(cmd, clientAuth, bytesSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxCloudFrontBytesCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxCloudFrontBytesCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxCloudFrontBytesCmd.PersistentFlags().String("query", "", "query")
+	AwsxCloudFrontBytesCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxCloudFrontBytesCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxCloudFrontBytesCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxCloudFrontBytesCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxCloudFrontBytesCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxCloudFrontBytesCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxCloudFrontBytesCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxCloudFrontBytesCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxCloudFrontBytesCmd.PersistentFlags().String("DistributionId", "", "cloudfront distribution id")
+	AwsxCloudFrontBytesCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxCloudFrontBytesCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxCloudFrontBytesCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxCloudFrontBytesCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
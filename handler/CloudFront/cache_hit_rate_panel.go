@@ -0,0 +1,73 @@
+package CloudFront
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var cacheHitRateSeries = []Metric.Series{
+	{Label: "CacheHitRate", MetricName: "CacheHitRate", Statistic: "Average"},
+}
+
+var AwsxCloudFrontCacheHitRateCmd = &cobra.Command{
+	Use:   "cloudfront_cache_hit_rate_panel",
+	Short: "get cache hit rate of the distribution",
+	Long:  `command to get the cache hit rate of the distribution. requires additional metrics to be enabled`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetCloudFrontCacheHitRatePanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting cloudfront cache hit rate: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetCloudFrontCacheHitRatePanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetCloudFrontDistributionPanel(cmd, clientAuth, cacheHitRateSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxCloudFrontCacheHitRateCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxCloudFrontCacheHitRateCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxCloudFrontCacheHitRateCmd.PersistentFlags().String("query", "", "query")
+	AwsxCloudFrontCacheHitRateCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxCloudFrontCacheHitRateCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxCloudFrontCacheHitRateCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxCloudFrontCacheHitRateCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxCloudFrontCacheHitRateCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxCloudFrontCacheHitRateCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxCloudFrontCacheHitRateCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxCloudFrontCacheHitRateCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxCloudFrontCacheHitRateCmd.PersistentFlags().String("DistributionId", "", "cloudfront distribution id")
+	AwsxCloudFrontCacheHitRateCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxCloudFrontCacheHitRateCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxCloudFrontCacheHitRateCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxCloudFrontCacheHitRateCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,60 @@
+package CloudFront
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+const namespace = "AWS/CloudFront"
+
+// period is the granularity CloudFront publishes its metrics at
+const period = 60
+
+// GetCloudFrontDistributionPanel resolves the distribution and returns the given series of it.
+func GetCloudFrontDistributionPanel(cmd *cobra.Command, clientAuth *model.Auth, series []Metric.Series, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	distributionId, err := GetCloudFrontDistributionId(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result, cloudwatchMetricData, err := Metric.GetMetricData(clientAuth, namespace, period, distributionDimensions(distributionId), series, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting distribution metric data: ", err)
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+// distributionDimensions returns the dimensions of the distribution metrics. CloudFront
+// publishes them with the fixed Region value Global.
+func distributionDimensions(distributionId string) []*cloudwatch.Dimension {
+	return []*cloudwatch.Dimension{
+		{
+			Name:  aws.String("DistributionId"),
+			Value: aws.String(distributionId),
+		},
+		{
+			Name:  aws.String("Region"),
+			Value: aws.String("Global"),
+		},
+	}
+}
@@ -0,0 +1,56 @@
+package CloudFront
+
+import (
+	"errors"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/cmdb"
+	"github.com/Appkube-awsx/awsx-common/config"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/spf13/cobra"
+)
+
+// metricsRegion is where CloudFront publishes its metrics and serves its API from,
+// whatever region the distribution's origins are in.
+const metricsRegion = "us-east-1"
+
+// GetCloudFrontDistributionId returns the distribution from the DistributionId flag, the
+// CMDB element or the instanceId flag, in that order.
+func GetCloudFrontDistributionId(cmd *cobra.Command) (string, error) {
+	distributionId, _ := cmd.PersistentFlags().GetString("DistributionId")
+	elementId, _ := cmd.PersistentFlags().GetString("elementId")
+	cmdbApiUrl, _ := cmd.PersistentFlags().GetString("cmdbApiUrl")
+	instanceId, _ := cmd.PersistentFlags().GetString("instanceId")
+
+	if distributionId != "" {
+		return distributionId, nil
+	}
+
+	if elementId != "" {
+		log.Println("getting cloud-element data from cmdb")
+		apiUrl := cmdbApiUrl
+		if cmdbApiUrl == "" {
+			log.Println("using default cmdb url")
+			apiUrl = config.CmdbUrl
+		}
+		log.Println("cmdb url: " + apiUrl)
+		cmdbData, err := cmdb.GetCloudElementData(apiUrl, elementId)
+		if err != nil {
+			return "", err
+		}
+		instanceId = cmdbData.InstanceId
+	}
+
+	if instanceId == "" {
+		return "", errors.New("distribution id not provided. provide DistributionId or elementId")
+	}
+	return instanceId, nil
+}
+
+// globalAuth returns a copy of clientAuth pointed at metricsRegion, so that the --zone
+// flag does not decide where CloudFront is queried.
+func globalAuth(clientAuth *model.Auth) *model.Auth {
+	auth := *clientAuth
+	auth.Region = metricsRegion
+	return &auth
+}
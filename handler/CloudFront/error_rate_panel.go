@@ -0,0 +1,75 @@
+package CloudFront
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var errorRateSeries = []Metric.Series{
+	{Label: "4xxErrorRate", MetricName: "4xxErrorRate", Statistic: "Average"},
+	{Label: "5xxErrorRate", MetricName: "5xxErrorRate", Statistic: "Average"},
+	{Label: "TotalErrorRate", MetricName: "TotalErrorRate", Statistic: "Average"},
+}
+
+var AwsxCloudFrontErrorRateCmd = &cobra.Command{
+	Use:   "cloudfront_error_rate_panel",
+	Short: "get error rates of the distribution",
+	Long:  `command to get the percentage of requests of the distribution answered with a 4xx or 5xx status`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetCloudFrontErrorRatePanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting cloudfront error rate: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetCloudFrontErrorRatePanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetCloudFrontDistributionPanel(cmd, clientAuth, errorRateSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxCloudFrontErrorRateCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxCloudFrontErrorRateCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxCloudFrontErrorRateCmd.PersistentFlags().String("query", "", "query")
+	AwsxCloudFrontErrorRateCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxCloudFrontErrorRateCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxCloudFrontErrorRateCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxCloudFrontErrorRateCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxCloudFrontErrorRateCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxCloudFrontErrorRateCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxCloudFrontErrorRateCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxCloudFrontErrorRateCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxCloudFrontErrorRateCmd.PersistentFlags().String("DistributionId", "", "cloudfront distribution id")
+	AwsxCloudFrontErrorRateCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxCloudFrontErrorRateCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxCloudFrontErrorRateCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxCloudFrontErrorRateCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
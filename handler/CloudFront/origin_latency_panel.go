@@ -0,0 +1,87 @@
+package CloudFront
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Statistic"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var defaultOriginLatencyStatistics = []string{"p50", "p90", "p99"}
+
+var AwsxCloudFrontOriginLatencyCmd = &cobra.Command{
+	Use:   "cloudfront_origin_latency_panel",
+	Short: "get origin latency percentiles of the distribution",
+	Long:  `command to get the origin latency percentiles of the distribution. requires additional metrics to be enabled`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetCloudFrontOriginLatencyPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting cloudfront origin latency: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetCloudFrontOriginLatencyPanel returns OriginLatency for each statistic of the stat flag,
+// p50, p90 and p99 by default. OriginLatency is an additional metric of the distribution.
+func GetCloudFrontOriginLatencyPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	statistics, err := Statistic.GetStatistics(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+	if statistics == nil {
+		statistics = defaultOriginLatencyStatistics
+	}
+
+	var series []Metric.Series
+	for _, statistic := range statistics {
+		series = append(series, Metric.Series{Label: statistic, MetricName: "OriginLatency", Statistic: statistic})
+	}
+	return GetCloudFrontDistributionPanel(cmd, clientAuth, series, cloudWatchClient)
+}
+
+func init() {
+	AwsxCloudFrontOriginLatencyCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxCloudFrontOriginLatencyCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxCloudFrontOriginLatencyCmd.PersistentFlags().String("query", "", "query")
+	AwsxCloudFrontOriginLatencyCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxCloudFrontOriginLatencyCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxCloudFrontOriginLatencyCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxCloudFrontOriginLatencyCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxCloudFrontOriginLatencyCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxCloudFrontOriginLatencyCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxCloudFrontOriginLatencyCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxCloudFrontOriginLatencyCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxCloudFrontOriginLatencyCmd.PersistentFlags().String("DistributionId", "", "cloudfront distribution id")
+	AwsxCloudFrontOriginLatencyCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxCloudFrontOriginLatencyCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxCloudFrontOriginLatencyCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxCloudFrontOriginLatencyCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+	AwsxCloudFrontOriginLatencyCmd.PersistentFlags().String("stat", "", "comma separated statistics. defaults to p50,p90,p99")
+}
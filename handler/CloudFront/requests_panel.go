@@ -0,0 +1,73 @@
+package CloudFront
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var requestsSeries = []Metric.Series{
+	{Label: "Requests", MetricName: "Requests", Statistic: "Sum"},
+}
+
+var AwsxCloudFrontRequestsCmd = &cobra.Command{
+	Use:   "cloudfront_requests_panel",
+	Short: "get requests of the distribution",
+	Long:  `command to get the viewer requests served by the distribution`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetCloudFrontRequestsPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting cloudfront requests: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetCloudFrontRequestsPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetCloudFrontDistributionPanel(cmd, clientAuth, requestsSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxCloudFrontRequestsCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxCloudFrontRequestsCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxCloudFrontRequestsCmd.PersistentFlags().String("query", "", "query")
+	AwsxCloudFrontRequestsCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxCloudFrontRequestsCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxCloudFrontRequestsCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxCloudFrontRequestsCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxCloudFrontRequestsCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxCloudFrontRequestsCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxCloudFrontRequestsCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxCloudFrontRequestsCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxCloudFrontRequestsCmd.PersistentFlags().String("DistributionId", "", "cloudfront distribution id")
+	AwsxCloudFrontRequestsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxCloudFrontRequestsCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxCloudFrontRequestsCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxCloudFrontRequestsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
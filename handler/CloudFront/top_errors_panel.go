@@ -0,0 +1,278 @@
+package CloudFront
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// defaultTopN is the number of errors returned without the topN flag
+const defaultTopN = 10
+
+type CloudFrontError struct {
+	Status int    `json:"status"`
+	Path   string `json:"path"`
+	Count  int64  `json:"count"`
+}
+
+var AwsxCloudFrontTopErrorsCmd = &cobra.Command{
+	Use:   "cloudfront_top_errors_panel",
+	Short: "get the top errors of the distribution",
+	Long:  `command to get the most frequent 4xx and 5xx responses of the distribution from its standard logs`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, table, err := GetCloudFrontTopErrorsPanel(cmd, clientAuth)
+			if err != nil {
+				log.Println("Error getting cloudfront top errors: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(table)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetCloudFrontTopErrorsPanel returns the most frequent 4xx and 5xx responses of the
+// distribution by status and path, read from the standard logs in its logging bucket.
+// Without startTime the last hour is read.
+func GetCloudFrontTopErrorsPanel(cmd *cobra.Command, clientAuth *model.Auth) (string, string, error) {
+	topNStr, _ := cmd.PersistentFlags().GetString("topN")
+
+	topN := defaultTopN
+	if topNStr != "" {
+		parsedTopN, err := strconv.Atoi(topNStr)
+		if err != nil || parsedTopN < 1 {
+			return "", "", fmt.Errorf("invalid topN %q", topNStr)
+		}
+		topN = parsedTopN
+	}
+
+	distributionId, err := GetCloudFrontDistributionId(cmd)
+	if err != nil {
+		return "", "", err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, time.Hour)
+	if err != nil {
+		return "", "", err
+	}
+
+	errors, err := GetCloudFrontTopErrors(clientAuth, distributionId, *startTime, *endTime, topN)
+	if err != nil {
+		log.Println("Error getting top errors: ", err)
+		return "", "", err
+	}
+
+	jsonString, err := json.Marshal(errors)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", "", err
+	}
+	return string(jsonString), printTopErrorsTable(errors), nil
+}
+
+// GetCloudFrontTopErrors reads the standard log files the distribution delivered for the
+// hours of the range and counts the requests within the range that got a 4xx or 5xx status.
+func GetCloudFrontTopErrors(clientAuth *model.Auth, distributionId string, startTime, endTime time.Time, topN int) ([]CloudFrontError, error) {
+	cloudFrontClient := awsclient.GetClient(*globalAuth(clientAuth), awsclient.CLOUD_FRONT_CLIENT).(*cloudfront.CloudFront)
+	distributionConfig, err := cloudFrontClient.GetDistributionConfig(&cloudfront.GetDistributionConfigInput{
+		Id: aws.String(distributionId),
+	})
+	if err != nil {
+		return nil, err
+	}
+	logging := distributionConfig.DistributionConfig.Logging
+	if logging == nil || !aws.BoolValue(logging.Enabled) {
+		return nil, fmt.Errorf("standard logging is not enabled for distribution %s", distributionId)
+	}
+
+	// the logging bucket is given by its domain name, e.g. mybucket.s3.amazonaws.com
+	bucket := aws.StringValue(logging.Bucket)
+	if i := strings.Index(bucket, ".s3."); i >= 0 {
+		bucket = bucket[:i]
+	}
+
+	bucketRegion, err := s3manager.GetBucketRegion(aws.BackgroundContext(), awsclient.GetSessionWithAssumeRole(*globalAuth(clientAuth)), bucket, metricsRegion)
+	if err != nil {
+		return nil, err
+	}
+	bucketAuth := *clientAuth
+	bucketAuth.Region = bucketRegion
+	s3Client := awsclient.GetClient(bucketAuth, awsclient.S3_CLIENT).(*s3.S3)
+
+	counts := map[CloudFrontError]int64{}
+	// log files are named <prefix><distribution id>.<YYYY-MM-DD-HH>.<unique id>.gz, in UTC
+	for hour := startTime.UTC().Truncate(time.Hour); hour.Before(endTime); hour = hour.Add(time.Hour) {
+		prefix := aws.StringValue(logging.Prefix) + distributionId + "." + hour.Format("2006-01-02-15")
+		var keys []string
+		err := s3Client.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+			Bucket: aws.String(bucket),
+			Prefix: aws.String(prefix),
+		}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+			for _, object := range page.Contents {
+				keys = append(keys, aws.StringValue(object.Key))
+			}
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, key := range keys {
+			object, err := s3Client.GetObject(&s3.GetObjectInput{
+				Bucket: aws.String(bucket),
+				Key:    aws.String(key),
+			})
+			if err != nil {
+				return nil, err
+			}
+			err = countErrors(object.Body, startTime, endTime, counts)
+			object.Body.Close()
+			if err != nil {
+				log.Printf("Error reading log file %s: %v", key, err)
+				return nil, err
+			}
+		}
+	}
+
+	errors := []CloudFrontError{}
+	for cloudFrontError, count := range counts {
+		cloudFrontError.Count = count
+		errors = append(errors, cloudFrontError)
+	}
+	sort.Slice(errors, func(i, j int) bool {
+		if errors[i].Count != errors[j].Count {
+			return errors[i].Count > errors[j].Count
+		}
+		if errors[i].Status != errors[j].Status {
+			return errors[i].Status < errors[j].Status
+		}
+		return errors[i].Path < errors[j].Path
+	})
+	if len(errors) > topN {
+		errors = errors[:topN]
+	}
+	return errors, nil
+}
+
+// countErrors adds the error responses of a gzipped standard log file to counts, keyed by
+// status and path. The columns are found from the #Fields header of the file.
+func countErrors(logFile io.Reader, startTime, endTime time.Time, counts map[CloudFrontError]int64) error {
+	reader, err := gzip.NewReader(logFile)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	columns := map[string]int{}
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#Fields:") {
+			for i, field := range strings.Fields(strings.TrimPrefix(line, "#Fields:")) {
+				columns[field] = i
+			}
+			continue
+		}
+		if strings.HasPrefix(line, "#") || len(columns) == 0 {
+			continue
+		}
+
+		values := strings.Split(line, "\t")
+		value := func(field string) string {
+			i, ok := columns[field]
+			if !ok || i >= len(values) {
+				return ""
+			}
+			return values[i]
+		}
+
+		status, err := strconv.Atoi(value("sc-status"))
+		if err != nil || status < 400 {
+			continue
+		}
+		timestamp, err := time.Parse("2006-01-02 15:04:05", value("date")+" "+value("time"))
+		if err != nil || timestamp.Before(startTime) || timestamp.After(endTime) {
+			continue
+		}
+		counts[CloudFrontError{Status: status, Path: value("cs-uri-stem")}]++
+	}
+	return scanner.Err()
+}
+
+func printTopErrorsTable(errors []CloudFrontError) string {
+	var buffer bytes.Buffer
+	if len(errors) == 0 {
+		buffer.WriteString("No errors found.")
+		return buffer.String()
+	}
+
+	table := tablewriter.NewWriter(&buffer)
+	table.SetHeader([]string{"Status", "Path", "Count"})
+	for _, cloudFrontError := range errors {
+		table.Append([]string{
+			strconv.Itoa(cloudFrontError.Status),
+			cloudFrontError.Path,
+			strconv.FormatInt(cloudFrontError.Count, 10),
+		})
+	}
+	table.Render()
+	return buffer.String()
+}
+
+func init() {
+	AwsxCloudFrontTopErrorsCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxCloudFrontTopErrorsCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxCloudFrontTopErrorsCmd.PersistentFlags().String("query", "", "query")
+	AwsxCloudFrontTopErrorsCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxCloudFrontTopErrorsCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxCloudFrontTopErrorsCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxCloudFrontTopErrorsCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxCloudFrontTopErrorsCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxCloudFrontTopErrorsCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxCloudFrontTopErrorsCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxCloudFrontTopErrorsCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxCloudFrontTopErrorsCmd.PersistentFlags().String("DistributionId", "", "cloudfront distribution id")
+	AwsxCloudFrontTopErrorsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxCloudFrontTopErrorsCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxCloudFrontTopErrorsCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxCloudFrontTopErrorsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+	AwsxCloudFrontTopErrorsCmd.PersistentFlags().String("topN", "", "number of errors returned. defaults to 10")
+}
@@ -0,0 +1,126 @@
+package DynamoDB
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+type CapacityMode struct {
+	TableName          string `json:"tableName"`
+	BillingMode        string `json:"billingMode"`
+	ReadCapacityUnits  int64  `json:"readCapacityUnits"`
+	WriteCapacityUnits int64  `json:"writeCapacityUnits"`
+	// LastUpdateToPayPerRequest is when the table last switched to on-demand, if ever
+	LastUpdateToPayPerRequest string `json:"lastUpdateToPayPerRequest,omitempty"`
+}
+
+var AwsxDynamoDBCapacityModeCmd = &cobra.Command{
+	Use:   "dynamodb_capacity_mode_panel",
+	Short: "get the capacity mode of the table",
+	Long:  `command to get whether the table is on-demand or provisioned`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, table, err := GetDynamoDBCapacityModePanel(cmd, clientAuth)
+			if err != nil {
+				log.Println("Error getting dynamodb capacity mode: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(table)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetDynamoDBCapacityModePanel returns whether the table is on-demand or provisioned, with
+// its provisioned throughput.
+func GetDynamoDBCapacityModePanel(cmd *cobra.Command, clientAuth *model.Auth) (string, string, error) {
+	tableName, err := GetDynamoDBTableName(cmd)
+	if err != nil {
+		return "", "", err
+	}
+
+	table, err := DescribeTable(clientAuth, tableName)
+	if err != nil {
+		log.Println("Error describing table: ", err)
+		return "", "", err
+	}
+
+	// tables created before on-demand existed have no billing mode summary
+	capacityMode := CapacityMode{
+		TableName:   tableName,
+		BillingMode: dynamodb.BillingModeProvisioned,
+	}
+	if table.BillingModeSummary != nil {
+		capacityMode.BillingMode = aws.StringValue(table.BillingModeSummary.BillingMode)
+		if table.BillingModeSummary.LastUpdateToPayPerRequestDateTime != nil {
+			capacityMode.LastUpdateToPayPerRequest = table.BillingModeSummary.LastUpdateToPayPerRequestDateTime.String()
+		}
+	}
+	if table.ProvisionedThroughput != nil {
+		capacityMode.ReadCapacityUnits = aws.Int64Value(table.ProvisionedThroughput.ReadCapacityUnits)
+		capacityMode.WriteCapacityUnits = aws.Int64Value(table.ProvisionedThroughput.WriteCapacityUnits)
+	}
+
+	jsonString, err := json.Marshal(capacityMode)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", "", err
+	}
+
+	var buffer bytes.Buffer
+	tableWriter := tablewriter.NewWriter(&buffer)
+	tableWriter.SetHeader([]string{"Table Name", "Billing Mode", "Read Capacity Units", "Write Capacity Units"})
+	tableWriter.Append([]string{
+		capacityMode.TableName,
+		capacityMode.BillingMode,
+		strconv.FormatInt(capacityMode.ReadCapacityUnits, 10),
+		strconv.FormatInt(capacityMode.WriteCapacityUnits, 10),
+	})
+	tableWriter.Render()
+
+	return string(jsonString), buffer.String(), nil
+}
+
+func init() {
+	AwsxDynamoDBCapacityModeCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxDynamoDBCapacityModeCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxDynamoDBCapacityModeCmd.PersistentFlags().String("query", "", "query")
+	AwsxDynamoDBCapacityModeCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxDynamoDBCapacityModeCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxDynamoDBCapacityModeCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxDynamoDBCapacityModeCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxDynamoDBCapacityModeCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxDynamoDBCapacityModeCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxDynamoDBCapacityModeCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxDynamoDBCapacityModeCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxDynamoDBCapacityModeCmd.PersistentFlags().String("TableName", "", "dynamodb table name")
+	AwsxDynamoDBCapacityModeCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxDynamoDBCapacityModeCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
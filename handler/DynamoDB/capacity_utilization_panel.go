@@ -0,0 +1,81 @@
+package DynamoDB
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+// consumed capacity is a Sum per period, so it is divided by the period to compare it
+// with the provisioned units per second. Provisioned capacity is published less often
+// than consumed capacity, hence the FILL.
+var capacitySeries = []Metric.Series{
+	{Label: "ConsumedReadCapacityUnits", Metrics: []Metric.MetricStat{{MetricName: "ConsumedReadCapacityUnits", Statistic: "Sum"}}, Expression: "{0} / PERIOD({0})"},
+	{Label: "ProvisionedReadCapacityUnits", MetricName: "ProvisionedReadCapacityUnits", Statistic: "Average"},
+	{Label: "ReadUtilization", Metrics: []Metric.MetricStat{{MetricName: "ConsumedReadCapacityUnits", Statistic: "Sum"}, {MetricName: "ProvisionedReadCapacityUnits", Statistic: "Average"}}, Expression: "100 * ({0} / PERIOD({0})) / FILL({1}, REPEAT)"},
+	{Label: "ConsumedWriteCapacityUnits", Metrics: []Metric.MetricStat{{MetricName: "ConsumedWriteCapacityUnits", Statistic: "Sum"}}, Expression: "{0} / PERIOD({0})"},
+	{Label: "ProvisionedWriteCapacityUnits", MetricName: "ProvisionedWriteCapacityUnits", Statistic: "Average"},
+	{Label: "WriteUtilization", Metrics: []Metric.MetricStat{{MetricName: "ConsumedWriteCapacityUnits", Statistic: "Sum"}, {MetricName: "ProvisionedWriteCapacityUnits", Statistic: "Average"}}, Expression: "100 * ({0} / PERIOD({0})) / FILL({1}, REPEAT)"},
+}
+
+var AwsxDynamoDBCapacityUtilizationCmd = &cobra.Command{
+	Use:   "dynamodb_capacity_utilization_panel",
+	Short: "get consumed and provisioned capacity of the table",
+	Long:  `command to get consumed vs provisioned read and write capacity and its utilization`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetDynamoDBCapacityUtilizationPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting dynamodb capacity utilization: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetDynamoDBCapacityUtilizationPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetDynamoDBTablePanel(cmd, clientAuth, capacitySeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxDynamoDBCapacityUtilizationCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxDynamoDBCapacityUtilizationCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxDynamoDBCapacityUtilizationCmd.PersistentFlags().String("query", "", "query")
+	AwsxDynamoDBCapacityUtilizationCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxDynamoDBCapacityUtilizationCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxDynamoDBCapacityUtilizationCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxDynamoDBCapacityUtilizationCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxDynamoDBCapacityUtilizationCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxDynamoDBCapacityUtilizationCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxDynamoDBCapacityUtilizationCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxDynamoDBCapacityUtilizationCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxDynamoDBCapacityUtilizationCmd.PersistentFlags().String("TableName", "", "dynamodb table name")
+	AwsxDynamoDBCapacityUtilizationCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxDynamoDBCapacityUtilizationCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxDynamoDBCapacityUtilizationCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxDynamoDBCapacityUtilizationCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
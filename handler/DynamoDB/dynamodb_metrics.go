@@ -0,0 +1,48 @@
+package DynamoDB
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+const namespace = "AWS/DynamoDB"
+
+// period is the granularity DynamoDB publishes its metrics at
+const period = 60
+
+// GetDynamoDBTablePanel resolves the table and returns the given series of it.
+func GetDynamoDBTablePanel(cmd *cobra.Command, clientAuth *model.Auth, series []Metric.Series, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	tableName, err := GetDynamoDBTableName(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result, cloudwatchMetricData, err := Metric.GetMetricData(clientAuth, namespace, period, tableDimensions(tableName), series, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting table metric data: ", err)
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func tableDimensions(tableName string) []*cloudwatch.Dimension {
+	return Metric.Dimensions("TableName", tableName)
+}
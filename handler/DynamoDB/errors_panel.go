@@ -0,0 +1,99 @@
+package DynamoDB
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var AwsxDynamoDBErrorsCmd = &cobra.Command{
+	Use:   "dynamodb_errors_panel",
+	Short: "get system and user errors",
+	Long:  `command to get table system errors and account user errors`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetDynamoDBErrorsPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting dynamodb errors: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetDynamoDBErrorsPanel returns the table SystemErrors summed over its operations, and
+// UserErrors, which DynamoDB only publishes for the whole account and region.
+func GetDynamoDBErrorsPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	tableName, err := GetDynamoDBTableName(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	series := []Metric.Series{
+		{Label: "SystemErrors", Expression: fmt.Sprintf(`SUM(SEARCH('{%s,Operation,TableName} MetricName="SystemErrors" TableName="%s"', 'Sum', %d))`, namespace, tableName, period)},
+		{Label: "UserErrors", Expression: fmt.Sprintf(`SUM(SEARCH('{%s} MetricName="UserErrors"', 'Sum', %d))`, namespace, period)},
+	}
+	result, cloudwatchMetricData, err := Metric.GetMetricData(clientAuth, namespace, period, nil, series, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting errors data: ", err)
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func init() {
+	AwsxDynamoDBErrorsCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxDynamoDBErrorsCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxDynamoDBErrorsCmd.PersistentFlags().String("query", "", "query")
+	AwsxDynamoDBErrorsCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxDynamoDBErrorsCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxDynamoDBErrorsCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxDynamoDBErrorsCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxDynamoDBErrorsCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxDynamoDBErrorsCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxDynamoDBErrorsCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxDynamoDBErrorsCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxDynamoDBErrorsCmd.PersistentFlags().String("TableName", "", "dynamodb table name")
+	AwsxDynamoDBErrorsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxDynamoDBErrorsCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxDynamoDBErrorsCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxDynamoDBErrorsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
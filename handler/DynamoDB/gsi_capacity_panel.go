@@ -0,0 +1,128 @@
+package DynamoDB
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var AwsxDynamoDBGSICapacityCmd = &cobra.Command{
+	Use:   "dynamodb_gsi_capacity_panel",
+	Short: "get capacity of the global secondary indexes",
+	Long:  `command to get consumed vs provisioned capacity of the table global secondary indexes`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetDynamoDBGSICapacityPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting dynamodb gsi capacity: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetDynamoDBGSICapacityPanel returns the capacity series of every global secondary index
+// of the table, keyed by index name. The GlobalSecondaryIndexName flag narrows the panel
+// to one index.
+func GetDynamoDBGSICapacityPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	indexName, _ := cmd.PersistentFlags().GetString("GlobalSecondaryIndexName")
+
+	tableName, err := GetDynamoDBTableName(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var indexNames []string
+	if indexName != "" {
+		indexNames = []string{indexName}
+	} else {
+		table, err := DescribeTable(clientAuth, tableName)
+		if err != nil {
+			log.Println("Error describing table: ", err)
+			return "", nil, err
+		}
+		for _, index := range table.GlobalSecondaryIndexes {
+			indexNames = append(indexNames, aws.StringValue(index.IndexName))
+		}
+	}
+
+	result := map[string]map[string][]Metric.DataPoint{}
+	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
+	for _, name := range indexNames {
+		dimensions := append(tableDimensions(tableName), &cloudwatch.Dimension{
+			Name:  aws.String("GlobalSecondaryIndexName"),
+			Value: aws.String(name),
+		})
+		indexSeries, rawData, err := Metric.GetMetricData(clientAuth, namespace, period, dimensions, capacitySeries, startTime, endTime, cloudWatchClient)
+		if err != nil {
+			log.Println("Error in getting index capacity data: ", err)
+			return "", nil, err
+		}
+		result[name] = indexSeries
+		output := &cloudwatch.GetMetricDataOutput{}
+		for _, s := range capacitySeries {
+			output.MetricDataResults = append(output.MetricDataResults, rawData[s.Label].MetricDataResults...)
+		}
+		cloudwatchMetricData[name] = output
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func init() {
+	AwsxDynamoDBGSICapacityCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxDynamoDBGSICapacityCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxDynamoDBGSICapacityCmd.PersistentFlags().String("query", "", "query")
+	AwsxDynamoDBGSICapacityCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxDynamoDBGSICapacityCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxDynamoDBGSICapacityCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxDynamoDBGSICapacityCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxDynamoDBGSICapacityCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxDynamoDBGSICapacityCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxDynamoDBGSICapacityCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxDynamoDBGSICapacityCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxDynamoDBGSICapacityCmd.PersistentFlags().String("TableName", "", "dynamodb table name")
+	AwsxDynamoDBGSICapacityCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxDynamoDBGSICapacityCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxDynamoDBGSICapacityCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxDynamoDBGSICapacityCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+	AwsxDynamoDBGSICapacityCmd.PersistentFlags().String("GlobalSecondaryIndexName", "", "global secondary index name. defaults to all indexes")
+}
@@ -0,0 +1,137 @@
+package DynamoDB
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Statistic"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+// operations are the Operation dimension values SuccessfulRequestLatency is published for
+var operations = []string{"GetItem", "PutItem", "UpdateItem", "DeleteItem", "Query", "Scan", "BatchGetItem", "BatchWriteItem", "TransactGetItems", "TransactWriteItems"}
+
+var AwsxDynamoDBLatencyByOperationCmd = &cobra.Command{
+	Use:   "dynamodb_latency_by_operation_panel",
+	Short: "get successful request latency per operation",
+	Long:  `command to get successful request latency of the table per operation`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetDynamoDBLatencyByOperationPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting dynamodb latency by operation: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetDynamoDBLatencyByOperationPanel returns SuccessfulRequestLatency per operation, keyed
+// by operation and then by statistic. The stat flag defaults to Average and the Operation
+// flag narrows the panel to one operation.
+func GetDynamoDBLatencyByOperationPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	operation, _ := cmd.PersistentFlags().GetString("Operation")
+
+	tableName, err := GetDynamoDBTableName(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	statistics, err := Statistic.GetStatistics(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+	if statistics == nil {
+		statistics = []string{"Average"}
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var series []Metric.Series
+	for _, statistic := range statistics {
+		series = append(series, Metric.Series{Label: statistic, MetricName: "SuccessfulRequestLatency", Statistic: statistic})
+	}
+
+	tableOperations := operations
+	if operation != "" {
+		tableOperations = []string{operation}
+	}
+
+	result := map[string]map[string][]Metric.DataPoint{}
+	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
+	for _, tableOperation := range tableOperations {
+		dimensions := append(tableDimensions(tableName), &cloudwatch.Dimension{
+			Name:  aws.String("Operation"),
+			Value: aws.String(tableOperation),
+		})
+		operationSeries, rawData, err := Metric.GetMetricData(clientAuth, namespace, period, dimensions, series, startTime, endTime, cloudWatchClient)
+		if err != nil {
+			log.Println("Error in getting latency data: ", err)
+			return "", nil, err
+		}
+		result[tableOperation] = operationSeries
+		output := &cloudwatch.GetMetricDataOutput{}
+		for _, s := range series {
+			output.MetricDataResults = append(output.MetricDataResults, rawData[s.Label].MetricDataResults...)
+		}
+		cloudwatchMetricData[tableOperation] = output
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func init() {
+	AwsxDynamoDBLatencyByOperationCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxDynamoDBLatencyByOperationCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxDynamoDBLatencyByOperationCmd.PersistentFlags().String("query", "", "query")
+	AwsxDynamoDBLatencyByOperationCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxDynamoDBLatencyByOperationCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxDynamoDBLatencyByOperationCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxDynamoDBLatencyByOperationCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxDynamoDBLatencyByOperationCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxDynamoDBLatencyByOperationCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxDynamoDBLatencyByOperationCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxDynamoDBLatencyByOperationCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxDynamoDBLatencyByOperationCmd.PersistentFlags().String("TableName", "", "dynamodb table name")
+	AwsxDynamoDBLatencyByOperationCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxDynamoDBLatencyByOperationCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxDynamoDBLatencyByOperationCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxDynamoDBLatencyByOperationCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+	AwsxDynamoDBLatencyByOperationCmd.PersistentFlags().String("Operation", "", "dynamodb operation, e.g. GetItem. defaults to all")
+	AwsxDynamoDBLatencyByOperationCmd.PersistentFlags().String("stat", "", "comma separated statistics. defaults to Average")
+}
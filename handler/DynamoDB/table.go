@@ -0,0 +1,60 @@
+package DynamoDB
+
+import (
+	"errors"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/cmdb"
+	"github.com/Appkube-awsx/awsx-common/config"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/spf13/cobra"
+)
+
+// GetDynamoDBTableName returns the table from the TableName flag, the CMDB element or
+// the instanceId flag, in that order.
+func GetDynamoDBTableName(cmd *cobra.Command) (string, error) {
+	tableName, _ := cmd.PersistentFlags().GetString("TableName")
+	elementId, _ := cmd.PersistentFlags().GetString("elementId")
+	cmdbApiUrl, _ := cmd.PersistentFlags().GetString("cmdbApiUrl")
+	instanceId, _ := cmd.PersistentFlags().GetString("instanceId")
+
+	if tableName != "" {
+		return tableName, nil
+	}
+
+	if elementId != "" {
+		log.Println("getting cloud-element data from cmdb")
+		apiUrl := cmdbApiUrl
+		if cmdbApiUrl == "" {
+			log.Println("using default cmdb url")
+			apiUrl = config.CmdbUrl
+		}
+		log.Println("cmdb url: " + apiUrl)
+		cmdbData, err := cmdb.GetCloudElementData(apiUrl, elementId)
+		if err != nil {
+			return "", err
+		}
+		instanceId = cmdbData.InstanceId
+	}
+
+	if instanceId == "" {
+		return "", errors.New("table name not provided. provide TableName or elementId")
+	}
+	return instanceId, nil
+}
+
+// DescribeTable returns the table description, which carries the billing mode,
+// provisioned throughput, indexes, size and item count.
+func DescribeTable(clientAuth *model.Auth, tableName string) (*dynamodb.TableDescription, error) {
+	dynamodbClient := awsclient.GetClient(*clientAuth, awsclient.DYNAMODB_CLIENT).(*dynamodb.DynamoDB)
+	output, err := dynamodbClient.DescribeTable(&dynamodb.DescribeTableInput{
+		TableName: aws.String(tableName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.Table, nil
+}
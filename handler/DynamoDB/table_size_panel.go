@@ -0,0 +1,111 @@
+package DynamoDB
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+type TableSize struct {
+	TableName      string `json:"tableName"`
+	TableSizeBytes int64  `json:"tableSizeBytes"`
+	ItemCount      int64  `json:"itemCount"`
+}
+
+var AwsxDynamoDBTableSizeCmd = &cobra.Command{
+	Use:   "dynamodb_table_size_panel",
+	Short: "get the size and item count of the table",
+	Long:  `command to get the size and item count of the table`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, table, err := GetDynamoDBTableSizePanel(cmd, clientAuth)
+			if err != nil {
+				log.Println("Error getting dynamodb table size: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(table)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetDynamoDBTableSizePanel returns the table size and item count. DynamoDB refreshes
+// both about every six hours.
+func GetDynamoDBTableSizePanel(cmd *cobra.Command, clientAuth *model.Auth) (string, string, error) {
+	tableName, err := GetDynamoDBTableName(cmd)
+	if err != nil {
+		return "", "", err
+	}
+
+	table, err := DescribeTable(clientAuth, tableName)
+	if err != nil {
+		log.Println("Error describing table: ", err)
+		return "", "", err
+	}
+
+	tableSize := TableSize{
+		TableName:      tableName,
+		TableSizeBytes: aws.Int64Value(table.TableSizeBytes),
+		ItemCount:      aws.Int64Value(table.ItemCount),
+	}
+
+	jsonString, err := json.Marshal(tableSize)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", "", err
+	}
+
+	var buffer bytes.Buffer
+	tableWriter := tablewriter.NewWriter(&buffer)
+	tableWriter.SetHeader([]string{"Table Name", "Table Size (Bytes)", "Item Count"})
+	tableWriter.Append([]string{
+		tableSize.TableName,
+		strconv.FormatInt(tableSize.TableSizeBytes, 10),
+		strconv.FormatInt(tableSize.ItemCount, 10),
+	})
+	tableWriter.Render()
+
+	return string(jsonString), buffer.String(), nil
+}
+
+func init() {
+	AwsxDynamoDBTableSizeCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxDynamoDBTableSizeCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxDynamoDBTableSizeCmd.PersistentFlags().String("query", "", "query")
+	AwsxDynamoDBTableSizeCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxDynamoDBTableSizeCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxDynamoDBTableSizeCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxDynamoDBTableSizeCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxDynamoDBTableSizeCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxDynamoDBTableSizeCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxDynamoDBTableSizeCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxDynamoDBTableSizeCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxDynamoDBTableSizeCmd.PersistentFlags().String("TableName", "", "dynamodb table name")
+	AwsxDynamoDBTableSizeCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxDynamoDBTableSizeCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
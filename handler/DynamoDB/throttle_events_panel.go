@@ -0,0 +1,74 @@
+package DynamoDB
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var throttleEventsSeries = []Metric.Series{
+	{Label: "ReadThrottleEvents", MetricName: "ReadThrottleEvents", Statistic: "Sum"},
+	{Label: "WriteThrottleEvents", MetricName: "WriteThrottleEvents", Statistic: "Sum"},
+}
+
+var AwsxDynamoDBThrottleEventsCmd = &cobra.Command{
+	Use:   "dynamodb_throttle_events_panel",
+	Short: "get read and write throttle events of the table",
+	Long:  `command to get read and write throttle events of the table`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetDynamoDBThrottleEventsPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting dynamodb throttle events: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetDynamoDBThrottleEventsPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetDynamoDBTablePanel(cmd, clientAuth, throttleEventsSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxDynamoDBThrottleEventsCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxDynamoDBThrottleEventsCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxDynamoDBThrottleEventsCmd.PersistentFlags().String("query", "", "query")
+	AwsxDynamoDBThrottleEventsCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxDynamoDBThrottleEventsCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxDynamoDBThrottleEventsCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxDynamoDBThrottleEventsCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxDynamoDBThrottleEventsCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxDynamoDBThrottleEventsCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxDynamoDBThrottleEventsCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxDynamoDBThrottleEventsCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxDynamoDBThrottleEventsCmd.PersistentFlags().String("TableName", "", "dynamodb table name")
+	AwsxDynamoDBThrottleEventsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxDynamoDBThrottleEventsCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxDynamoDBThrottleEventsCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxDynamoDBThrottleEventsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,75 @@
+package EC2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var ebsBurstBalanceSeries = []Metric.Series{
+	{Label: "BurstBalance", MetricName: "BurstBalance", Statistic: "Average"},
+}
+
+var AwsxEc2EBSBurstBalanceCmd = &cobra.Command{
+	Use:   "ebs_burst_balance_panel",
+	Short: "get burst balance of the instance volumes",
+	Long:  `command to get the remaining burst bucket credits of the instance gp2, st1 and sc1 volumes`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetEBSBurstBalancePanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting ebs burst balance: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetEBSBurstBalancePanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetEBSVolumePanel(cmd, clientAuth, ebsBurstBalanceSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxEc2EBSBurstBalanceCmd.PersistentFlags().String("rootvolumeId", "", "root volume id")
+	AwsxEc2EBSBurstBalanceCmd.PersistentFlags().String("ebsvolume1Id", "", "ebs volume 1 id")
+	AwsxEc2EBSBurstBalanceCmd.PersistentFlags().String("ebsvolume2Id", "", "ebs volume 2 id")
+	AwsxEc2EBSBurstBalanceCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxEc2EBSBurstBalanceCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxEc2EBSBurstBalanceCmd.PersistentFlags().String("query", "", "query")
+	AwsxEc2EBSBurstBalanceCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxEc2EBSBurstBalanceCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxEc2EBSBurstBalanceCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxEc2EBSBurstBalanceCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxEc2EBSBurstBalanceCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxEc2EBSBurstBalanceCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxEc2EBSBurstBalanceCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxEc2EBSBurstBalanceCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxEc2EBSBurstBalanceCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxEc2EBSBurstBalanceCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxEc2EBSBurstBalanceCmd.PersistentFlags().String("endTime", "", "endcl time")
+	AwsxEc2EBSBurstBalanceCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,75 @@
+package EC2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var ebsVolumeIdleTimeSeries = []Metric.Series{
+	{Label: "IdleTimePercent", Metrics: []Metric.MetricStat{{MetricName: "VolumeIdleTime", Statistic: "Sum"}}, Expression: "100 * {0} / PERIOD({0})"},
+}
+
+var AwsxEc2EBSVolumeIdleTimeCmd = &cobra.Command{
+	Use:   "ebs_volume_idle_time_panel",
+	Short: "get idle time percentage of the instance volumes",
+	Long:  `command to get the percentage of time the instance EBS volumes had no read or write operations`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetEBSVolumeIdleTimePanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting ebs volume idle time: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetEBSVolumeIdleTimePanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetEBSVolumePanel(cmd, clientAuth, ebsVolumeIdleTimeSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxEc2EBSVolumeIdleTimeCmd.PersistentFlags().String("rootvolumeId", "", "root volume id")
+	AwsxEc2EBSVolumeIdleTimeCmd.PersistentFlags().String("ebsvolume1Id", "", "ebs volume 1 id")
+	AwsxEc2EBSVolumeIdleTimeCmd.PersistentFlags().String("ebsvolume2Id", "", "ebs volume 2 id")
+	AwsxEc2EBSVolumeIdleTimeCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxEc2EBSVolumeIdleTimeCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxEc2EBSVolumeIdleTimeCmd.PersistentFlags().String("query", "", "query")
+	AwsxEc2EBSVolumeIdleTimeCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxEc2EBSVolumeIdleTimeCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxEc2EBSVolumeIdleTimeCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxEc2EBSVolumeIdleTimeCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxEc2EBSVolumeIdleTimeCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxEc2EBSVolumeIdleTimeCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxEc2EBSVolumeIdleTimeCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxEc2EBSVolumeIdleTimeCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxEc2EBSVolumeIdleTimeCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxEc2EBSVolumeIdleTimeCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxEc2EBSVolumeIdleTimeCmd.PersistentFlags().String("endTime", "", "endcl time")
+	AwsxEc2EBSVolumeIdleTimeCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,76 @@
+package EC2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var ebsVolumeIOPSSeries = []Metric.Series{
+	{Label: "ReadOps", Metrics: []Metric.MetricStat{{MetricName: "VolumeReadOps", Statistic: "Sum"}}, Expression: "{0} / PERIOD({0})"},
+	{Label: "WriteOps", Metrics: []Metric.MetricStat{{MetricName: "VolumeWriteOps", Statistic: "Sum"}}, Expression: "{0} / PERIOD({0})"},
+}
+
+var AwsxEc2EBSVolumeIOPSCmd = &cobra.Command{
+	Use:   "ebs_volume_iops_panel",
+	Short: "get read and write operations per second of the instance volumes",
+	Long:  `command to get read and write operations per second of the instance EBS volumes`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetEBSVolumeIOPSPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting ebs volume iops: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetEBSVolumeIOPSPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetEBSVolumePanel(cmd, clientAuth, ebsVolumeIOPSSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxEc2EBSVolumeIOPSCmd.PersistentFlags().String("rootvolumeId", "", "root volume id")
+	AwsxEc2EBSVolumeIOPSCmd.PersistentFlags().String("ebsvolume1Id", "", "ebs volume 1 id")
+	AwsxEc2EBSVolumeIOPSCmd.PersistentFlags().String("ebsvolume2Id", "", "ebs volume 2 id")
+	AwsxEc2EBSVolumeIOPSCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxEc2EBSVolumeIOPSCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxEc2EBSVolumeIOPSCmd.PersistentFlags().String("query", "", "query")
+	AwsxEc2EBSVolumeIOPSCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxEc2EBSVolumeIOPSCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxEc2EBSVolumeIOPSCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxEc2EBSVolumeIOPSCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxEc2EBSVolumeIOPSCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxEc2EBSVolumeIOPSCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxEc2EBSVolumeIOPSCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxEc2EBSVolumeIOPSCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxEc2EBSVolumeIOPSCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxEc2EBSVolumeIOPSCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxEc2EBSVolumeIOPSCmd.PersistentFlags().String("endTime", "", "endcl time")
+	AwsxEc2EBSVolumeIOPSCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
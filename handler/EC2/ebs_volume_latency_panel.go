@@ -0,0 +1,76 @@
+package EC2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var ebsVolumeLatencySeries = []Metric.Series{
+	{Label: "ReadLatency", Metrics: []Metric.MetricStat{{MetricName: "VolumeTotalReadTime", Statistic: "Sum"}, {MetricName: "VolumeReadOps", Statistic: "Sum"}}, Expression: "IF({1} > 0, 1000 * {0} / {1}, 0)"},
+	{Label: "WriteLatency", Metrics: []Metric.MetricStat{{MetricName: "VolumeTotalWriteTime", Statistic: "Sum"}, {MetricName: "VolumeWriteOps", Statistic: "Sum"}}, Expression: "IF({1} > 0, 1000 * {0} / {1}, 0)"},
+}
+
+var AwsxEc2EBSVolumeLatencyCmd = &cobra.Command{
+	Use:   "ebs_volume_latency_panel",
+	Short: "get read and write latency of the instance volumes",
+	Long:  `command to get the average read and write latency in milliseconds of the instance EBS volumes`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetEBSVolumeLatencyPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting ebs volume latency: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetEBSVolumeLatencyPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetEBSVolumePanel(cmd, clientAuth, ebsVolumeLatencySeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxEc2EBSVolumeLatencyCmd.PersistentFlags().String("rootvolumeId", "", "root volume id")
+	AwsxEc2EBSVolumeLatencyCmd.PersistentFlags().String("ebsvolume1Id", "", "ebs volume 1 id")
+	AwsxEc2EBSVolumeLatencyCmd.PersistentFlags().String("ebsvolume2Id", "", "ebs volume 2 id")
+	AwsxEc2EBSVolumeLatencyCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxEc2EBSVolumeLatencyCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxEc2EBSVolumeLatencyCmd.PersistentFlags().String("query", "", "query")
+	AwsxEc2EBSVolumeLatencyCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxEc2EBSVolumeLatencyCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxEc2EBSVolumeLatencyCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxEc2EBSVolumeLatencyCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxEc2EBSVolumeLatencyCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxEc2EBSVolumeLatencyCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxEc2EBSVolumeLatencyCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxEc2EBSVolumeLatencyCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxEc2EBSVolumeLatencyCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxEc2EBSVolumeLatencyCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxEc2EBSVolumeLatencyCmd.PersistentFlags().String("endTime", "", "endcl time")
+	AwsxEc2EBSVolumeLatencyCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
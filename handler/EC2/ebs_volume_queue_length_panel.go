@@ -0,0 +1,75 @@
+package EC2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var ebsVolumeQueueLengthSeries = []Metric.Series{
+	{Label: "QueueLength", MetricName: "VolumeQueueLength", Statistic: "Average"},
+}
+
+var AwsxEc2EBSVolumeQueueLengthCmd = &cobra.Command{
+	Use:   "ebs_volume_queue_length_panel",
+	Short: "get queue length of the instance volumes",
+	Long:  `command to get the number of pending I/O requests of the instance EBS volumes`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetEBSVolumeQueueLengthPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting ebs volume queue length: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetEBSVolumeQueueLengthPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetEBSVolumePanel(cmd, clientAuth, ebsVolumeQueueLengthSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxEc2EBSVolumeQueueLengthCmd.PersistentFlags().String("rootvolumeId", "", "root volume id")
+	AwsxEc2EBSVolumeQueueLengthCmd.PersistentFlags().String("ebsvolume1Id", "", "ebs volume 1 id")
+	AwsxEc2EBSVolumeQueueLengthCmd.PersistentFlags().String("ebsvolume2Id", "", "ebs volume 2 id")
+	AwsxEc2EBSVolumeQueueLengthCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxEc2EBSVolumeQueueLengthCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxEc2EBSVolumeQueueLengthCmd.PersistentFlags().String("query", "", "query")
+	AwsxEc2EBSVolumeQueueLengthCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxEc2EBSVolumeQueueLengthCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxEc2EBSVolumeQueueLengthCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxEc2EBSVolumeQueueLengthCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxEc2EBSVolumeQueueLengthCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxEc2EBSVolumeQueueLengthCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxEc2EBSVolumeQueueLengthCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxEc2EBSVolumeQueueLengthCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxEc2EBSVolumeQueueLengthCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxEc2EBSVolumeQueueLengthCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxEc2EBSVolumeQueueLengthCmd.PersistentFlags().String("endTime", "", "endcl time")
+	AwsxEc2EBSVolumeQueueLengthCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
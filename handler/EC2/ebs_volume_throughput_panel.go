@@ -0,0 +1,76 @@
+package EC2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var ebsVolumeThroughputSeries = []Metric.Series{
+	{Label: "ReadBytes", Metrics: []Metric.MetricStat{{MetricName: "VolumeReadBytes", Statistic: "Sum"}}, Expression: "{0} / PERIOD({0})"},
+	{Label: "WriteBytes", Metrics: []Metric.MetricStat{{MetricName: "VolumeWriteBytes", Statistic: "Sum"}}, Expression: "{0} / PERIOD({0})"},
+}
+
+var AwsxEc2EBSVolumeThroughputCmd = &cobra.Command{
+	Use:   "ebs_volume_throughput_panel",
+	Short: "get read and write bytes per second of the instance volumes",
+	Long:  `command to get read and write bytes per second of the instance EBS volumes`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetEBSVolumeThroughputPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting ebs volume throughput: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetEBSVolumeThroughputPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetEBSVolumePanel(cmd, clientAuth, ebsVolumeThroughputSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxEc2EBSVolumeThroughputCmd.PersistentFlags().String("rootvolumeId", "", "root volume id")
+	AwsxEc2EBSVolumeThroughputCmd.PersistentFlags().String("ebsvolume1Id", "", "ebs volume 1 id")
+	AwsxEc2EBSVolumeThroughputCmd.PersistentFlags().String("ebsvolume2Id", "", "ebs volume 2 id")
+	AwsxEc2EBSVolumeThroughputCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxEc2EBSVolumeThroughputCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxEc2EBSVolumeThroughputCmd.PersistentFlags().String("query", "", "query")
+	AwsxEc2EBSVolumeThroughputCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxEc2EBSVolumeThroughputCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxEc2EBSVolumeThroughputCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxEc2EBSVolumeThroughputCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxEc2EBSVolumeThroughputCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxEc2EBSVolumeThroughputCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxEc2EBSVolumeThroughputCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxEc2EBSVolumeThroughputCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxEc2EBSVolumeThroughputCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxEc2EBSVolumeThroughputCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxEc2EBSVolumeThroughputCmd.PersistentFlags().String("endTime", "", "endcl time")
+	AwsxEc2EBSVolumeThroughputCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,152 @@
+package EC2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/cmdb"
+	"github.com/Appkube-awsx/awsx-common/config"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/spf13/cobra"
+)
+
+type EBSVolume struct {
+	VolumeId string `json:"volumeId"`
+	// Label is the volume flag the id came from, or the attachment device when discovered
+	Label      string `json:"label"`
+	VolumeType string `json:"volumeType,omitempty"`
+}
+
+type EBSVolumeSeries struct {
+	EBSVolume
+	Metrics map[string][]Metric.DataPoint `json:"metrics"`
+}
+
+// GetEBSVolumePanel resolves the instance volumes and returns the given series for each
+// of them, keyed by volume id in the raw output.
+func GetEBSVolumePanel(cmd *cobra.Command, clientAuth *model.Auth, series []Metric.Series, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	elementId, _ := cmd.PersistentFlags().GetString("elementId")
+	cmdbApiUrl, _ := cmd.PersistentFlags().GetString("cmdbApiUrl")
+	instanceId, _ := cmd.PersistentFlags().GetString("instanceId")
+
+	if elementId != "" {
+		log.Println("getting cloud-element data from cmdb")
+		apiUrl := cmdbApiUrl
+		if cmdbApiUrl == "" {
+			log.Println("using default cmdb url")
+			apiUrl = config.CmdbUrl
+		}
+		log.Println("cmdb url: " + apiUrl)
+		cmdbData, err := cmdb.GetCloudElementData(apiUrl, elementId)
+		if err != nil {
+			return "", nil, err
+		}
+		instanceId = cmdbData.InstanceId
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 15*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	log.Printf("StartTime: %v, EndTime: %v", startTime, endTime)
+
+	volumes, err := GetInstanceVolumes(cmd, clientAuth, instanceId)
+	if err != nil {
+		log.Println("Error getting instance volumes: ", err)
+		return "", nil, err
+	}
+
+	volumeSeries, cloudwatchMetricData, err := GetEBSVolumeMetricData(clientAuth, volumes, series, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting volume metric data: ", err)
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(volumeSeries)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+// GetInstanceVolumes returns the volumes given by the rootvolumeId, ebsvolume1Id and
+// ebsvolume2Id flags, or the volumes attached to the instance when none are set.
+func GetInstanceVolumes(cmd *cobra.Command, clientAuth *model.Auth, instanceId string) ([]EBSVolume, error) {
+	var volumes []EBSVolume
+	for _, flag := range []string{"rootvolumeId", "ebsvolume1Id", "ebsvolume2Id"} {
+		volumeId, _ := cmd.PersistentFlags().GetString(flag)
+		if volumeId != "" {
+			volumes = append(volumes, EBSVolume{VolumeId: volumeId, Label: strings.TrimSuffix(flag, "Id")})
+		}
+	}
+	if len(volumes) > 0 {
+		return volumes, nil
+	}
+
+	if instanceId == "" {
+		return nil, errors.New("instance id or volume ids not provided")
+	}
+
+	ec2Client := awsclient.GetClient(*clientAuth, awsclient.EC2_CLIENT).(*ec2.EC2)
+	input := &ec2.DescribeVolumesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("attachment.instance-id"),
+				Values: []*string{aws.String(instanceId)},
+			},
+		},
+	}
+	err := ec2Client.DescribeVolumesPages(input, func(page *ec2.DescribeVolumesOutput, lastPage bool) bool {
+		for _, volume := range page.Volumes {
+			ebsVolume := EBSVolume{
+				VolumeId:   aws.StringValue(volume.VolumeId),
+				VolumeType: aws.StringValue(volume.VolumeType),
+			}
+			for _, attachment := range volume.Attachments {
+				if aws.StringValue(attachment.InstanceId) == instanceId {
+					ebsVolume.Label = aws.StringValue(attachment.Device)
+				}
+			}
+			volumes = append(volumes, ebsVolume)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(volumes) == 0 {
+		return nil, fmt.Errorf("no volumes attached to instance %s", instanceId)
+	}
+	return volumes, nil
+}
+
+// GetEBSVolumeMetricData queries AWS/EBS for every series of every volume.
+func GetEBSVolumeMetricData(clientAuth *model.Auth, volumes []EBSVolume, series []Metric.Series, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) ([]EBSVolumeSeries, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	volumeDimensions := map[string][]*cloudwatch.Dimension{}
+	for _, volume := range volumes {
+		volumeDimensions[volume.VolumeId] = Metric.Dimensions("VolumeId", volume.VolumeId)
+	}
+
+	result, cloudwatchMetricData, err := Metric.GetEntityMetricData(clientAuth, "AWS/EBS", 300, volumeDimensions, series, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	volumeSeries := make([]EBSVolumeSeries, len(volumes))
+	for i, volume := range volumes {
+		volumeSeries[i] = EBSVolumeSeries{EBSVolume: volume, Metrics: result[volume.VolumeId]}
+	}
+	return volumeSeries, cloudwatchMetricData, nil
+}
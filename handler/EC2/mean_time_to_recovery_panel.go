@@ -0,0 +1,77 @@
+package EC2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Alarm"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var AwsxEc2MeanTimeToRecoveryCmd = &cobra.Command{
+	Use:   "mean_time_to_recovery_panel",
+	Short: "get mean time to recovery data",
+	Long:  `command to get mean time to recovery data`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, recoveryStats, err := GetEC2MeanTimeToRecoveryPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting mean time to recovery: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(recoveryStats)
+			} else {
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetEC2MeanTimeToRecoveryPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, *Alarm.RecoveryStats, error) {
+	// A failed status check marks the instance as down
+	return Alarm.GetMeanTimeToRecoveryPanel(cmd, clientAuth, "EC2", func(instanceId string) *Alarm.HealthMetric {
+		return &Alarm.HealthMetric{
+			Namespace:  "AWS/EC2",
+			MetricName: "StatusCheckFailed",
+			Dimensions: map[string]string{"InstanceId": instanceId},
+			Stat:       "Maximum",
+			Period:     60,
+			Failing:    func(value float64) bool { return value >= 1 },
+		}
+	}, cloudWatchClient)
+}
+
+func init() {
+	AwsxEc2MeanTimeToRecoveryCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxEc2MeanTimeToRecoveryCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxEc2MeanTimeToRecoveryCmd.PersistentFlags().String("query", "", "query")
+	AwsxEc2MeanTimeToRecoveryCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxEc2MeanTimeToRecoveryCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxEc2MeanTimeToRecoveryCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxEc2MeanTimeToRecoveryCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxEc2MeanTimeToRecoveryCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxEc2MeanTimeToRecoveryCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxEc2MeanTimeToRecoveryCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxEc2MeanTimeToRecoveryCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxEc2MeanTimeToRecoveryCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxEc2MeanTimeToRecoveryCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxEc2MeanTimeToRecoveryCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxEc2MeanTimeToRecoveryCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
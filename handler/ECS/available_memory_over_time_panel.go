@@ -114,8 +114,17 @@ func GetAvailableMemoryOverTimeData(cmd *cobra.Command, clientAuth *model.Auth,
 	// Debug prints
 	log.Printf("StartTime: %v, EndTime: %v", startTime, endTime)
 
+	groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+	if groupBy == "service" {
+		return GetECSMetricByService(cmd, clientAuth, instanceId, "ECS/ContainerInsights", "Average", 300, []string{"MemoryReserved", "MemoryUtilized"}, startTime, endTime, cloudWatchClient)
+	}
+	dimensions, err := GetECSDimensions(cmd, instanceId, "ECS/ContainerInsights")
+	if err != nil {
+		return "", nil, err
+	}
+
 	// Fetch raw data
-	rawData, err := GetAvailableMemoryOverTimeMetricData(clientAuth, instanceId, elementType, startTime, endTime, cloudWatchClient)
+	rawData, err := GetAvailableMemoryOverTimeMetricData(clientAuth, dimensions, elementType, startTime, endTime, cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting raw data: ", err)
 		return "", nil, err
@@ -168,7 +177,7 @@ func GetAvailableMemoryOverTimeData(cmd *cobra.Command, clientAuth *model.Auth,
 	return string(jsonString), cloudwatchMetricData, nil
 }
 
-func GetAvailableMemoryOverTimeMetricData(clientAuth *model.Auth, instanceId, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+func GetAvailableMemoryOverTimeMetricData(clientAuth *model.Auth, dimensions []*cloudwatch.Dimension, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
 
 	elmType := "ECS/ContainerInsights"
 	input := &cloudwatch.GetMetricDataInput{
@@ -179,12 +188,7 @@ func GetAvailableMemoryOverTimeMetricData(clientAuth *model.Auth, instanceId, el
 				Id: aws.String("memory_reserved"),
 				MetricStat: &cloudwatch.MetricStat{
 					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ClusterName"),
-								Value: aws.String(instanceId),
-							},
-						},
+						Dimensions: dimensions,
 						MetricName: aws.String("MemoryReserved"),
 						Namespace:  aws.String(elmType),
 					},
@@ -196,12 +200,7 @@ func GetAvailableMemoryOverTimeMetricData(clientAuth *model.Auth, instanceId, el
 				Id: aws.String("memory_utilized"),
 				MetricStat: &cloudwatch.MetricStat{
 					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ClusterName"),
-								Value: aws.String(instanceId),
-							},
-						},
+						Dimensions: dimensions,
 						MetricName: aws.String("MemoryUtilized"),
 						Namespace:  aws.String(elmType),
 					},
@@ -268,6 +267,9 @@ func init() {
 	AwsxECSAvailableMemoryOverTimeCmd.PersistentFlags().String("externalId", "", "aws external id")
 	AwsxECSAvailableMemoryOverTimeCmd.PersistentFlags().String("cloudWatchQueries", "", "aws cloudwatch metric queries")
 	AwsxECSAvailableMemoryOverTimeCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxECSAvailableMemoryOverTimeCmd.PersistentFlags().String("ServiceName", "", "Service Name")
+	AwsxECSAvailableMemoryOverTimeCmd.PersistentFlags().String("TaskDefinitionFamily", "", "ECS task definition family")
+	AwsxECSAvailableMemoryOverTimeCmd.PersistentFlags().String("groupBy", "", "group series by. service")
 	AwsxECSAvailableMemoryOverTimeCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxECSAvailableMemoryOverTimeCmd.PersistentFlags().String("endTime", "", "endcl time")
 	AwsxECSAvailableMemoryOverTimeCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
@@ -112,8 +112,17 @@ func GetContainerMemoryUsageData(cmd *cobra.Command, clientAuth *model.Auth, clo
 
 	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
 
+	groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+	if groupBy == "service" {
+		return GetECSMetricByService(cmd, clientAuth, instanceId, "ECS/ContainerInsights", "Average", 300, []string{"MemoryUtilized"}, startTime, endTime, cloudWatchClient)
+	}
+	dimensions, err := GetECSDimensions(cmd, instanceId, "ECS/ContainerInsights")
+	if err != nil {
+		return "", nil, err
+	}
+
 	// Fetch raw data
-	rawData, err := GetContainerMemoryUsageMetricData(clientAuth, instanceId, elementType, startTime, endTime, cloudWatchClient)
+	rawData, err := GetContainerMemoryUsageMetricData(clientAuth, dimensions, elementType, startTime, endTime, cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting raw data: ", err)
 		return "", nil, err
@@ -131,7 +140,7 @@ func GetContainerMemoryUsageData(cmd *cobra.Command, clientAuth *model.Auth, clo
 	return string(jsonString), cloudwatchMetricData, nil
 }
 
-func GetContainerMemoryUsageMetricData(clientAuth *model.Auth, instanceId, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+func GetContainerMemoryUsageMetricData(clientAuth *model.Auth, dimensions []*cloudwatch.Dimension, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
 
 	elmType := "ECS/ContainerInsights"
 	input := &cloudwatch.GetMetricDataInput{
@@ -142,12 +151,7 @@ func GetContainerMemoryUsageMetricData(clientAuth *model.Auth, instanceId, eleme
 				Id: aws.String("m1"),
 				MetricStat: &cloudwatch.MetricStat{
 					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ClusterName"),
-								Value: aws.String(instanceId),
-							},
-						},
+						Dimensions: dimensions,
 						MetricName: aws.String("MemoryUtilized"),
 						Namespace:  aws.String(elmType),
 					},
@@ -197,6 +201,9 @@ func init() {
 	AwsxECSContainerMemoryUsageCmd.PersistentFlags().String("externalId", "", "aws external id")
 	AwsxECSContainerMemoryUsageCmd.PersistentFlags().String("cloudWatchQueries", "", "aws cloudwatch metric queries")
 	AwsxECSContainerMemoryUsageCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxECSContainerMemoryUsageCmd.PersistentFlags().String("ServiceName", "", "Service Name")
+	AwsxECSContainerMemoryUsageCmd.PersistentFlags().String("TaskDefinitionFamily", "", "ECS task definition family")
+	AwsxECSContainerMemoryUsageCmd.PersistentFlags().String("groupBy", "", "group series by. service")
 	AwsxECSContainerMemoryUsageCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxECSContainerMemoryUsageCmd.PersistentFlags().String("endTime", "", "endcl time")
 	AwsxECSContainerMemoryUsageCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
@@ -113,8 +113,17 @@ func GetECSContainerNetRxInBytesPanel(cmd *cobra.Command, clientAuth *model.Auth
 
 	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
 
+	groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+	if groupBy == "service" {
+		return GetECSMetricByService(cmd, clientAuth, instanceId, "ECS/ContainerInsights", "Sum", 60, []string{"NetworkRxBytes"}, startTime, endTime, cloudWatchClient)
+	}
+	dimensions, err := GetECSDimensions(cmd, instanceId, "ECS/ContainerInsights")
+	if err != nil {
+		return "", nil, err
+	}
+
 	// Fetch raw data
-	rawData, err := GetECSContainerNetRxInBytesMetricData(clientAuth, instanceId, elementType, startTime, endTime, "Sum", cloudWatchClient)
+	rawData, err := GetECSContainerNetRxInBytesMetricData(clientAuth, dimensions, elementType, startTime, endTime, "Sum", cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting raw data: ", err)
 		return "", nil, err
@@ -132,8 +141,8 @@ func GetECSContainerNetRxInBytesPanel(cmd *cobra.Command, clientAuth *model.Auth
 	return string(jsonString), cloudwatchMetricData, nil
 }
 
-func GetECSContainerNetRxInBytesMetricData(clientAuth *model.Auth, instanceID, elementType string, startTime, endTime *time.Time, statistic string, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
-	log.Printf("Getting metric data for instance %s in namespace %s from %v to %v", instanceID, elementType, startTime, endTime)
+func GetECSContainerNetRxInBytesMetricData(clientAuth *model.Auth, dimensions []*cloudwatch.Dimension, elementType string, startTime, endTime *time.Time, statistic string, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+	log.Printf("Getting metric data for dimensions %v in namespace %s from %v to %v", dimensions, elementType, startTime, endTime)
 
 	elmType := "ECS/ContainerInsights"
 	// if elementType == "ECS" {
@@ -147,12 +156,7 @@ func GetECSContainerNetRxInBytesMetricData(clientAuth *model.Auth, instanceID, e
 				Id: aws.String("m1"),
 				MetricStat: &cloudwatch.MetricStat{
 					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ClusterName"),
-								Value: aws.String(instanceID),
-							},
-						},
+						Dimensions: dimensions,
 						MetricName: aws.String("NetworkRxBytes"),
 						Namespace:  aws.String(elmType),
 					},
@@ -204,6 +208,9 @@ func init() {
 	AwsxECSContainerNetRxInBytesCmd.PersistentFlags().String("externalId", "", "aws external id")
 	AwsxECSContainerNetRxInBytesCmd.PersistentFlags().String("cloudWatchQueries", "", "aws cloudwatch metric queries")
 	AwsxECSContainerNetRxInBytesCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxECSContainerNetRxInBytesCmd.PersistentFlags().String("ServiceName", "", "Service Name")
+	AwsxECSContainerNetRxInBytesCmd.PersistentFlags().String("TaskDefinitionFamily", "", "ECS task definition family")
+	AwsxECSContainerNetRxInBytesCmd.PersistentFlags().String("groupBy", "", "group series by. service")
 	AwsxECSContainerNetRxInBytesCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxECSContainerNetRxInBytesCmd.PersistentFlags().String("endTime", "", "endcl time")
 	AwsxECSContainerNetRxInBytesCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
@@ -113,8 +113,17 @@ func GetECSContainerNetTxInBytesPanel(cmd *cobra.Command, clientAuth *model.Auth
 
 	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
 
+	groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+	if groupBy == "service" {
+		return GetECSMetricByService(cmd, clientAuth, instanceId, "ECS/ContainerInsights", "Sum", 60, []string{"NetworkTxBytes"}, startTime, endTime, cloudWatchClient)
+	}
+	dimensions, err := GetECSDimensions(cmd, instanceId, "ECS/ContainerInsights")
+	if err != nil {
+		return "", nil, err
+	}
+
 	// Fetch raw data
-	rawData, err := GetECSContainerNetTxInBytesMetricData(clientAuth, instanceId, elementType, startTime, endTime, "Sum", cloudWatchClient)
+	rawData, err := GetECSContainerNetTxInBytesMetricData(clientAuth, dimensions, elementType, startTime, endTime, "Sum", cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting raw data: ", err)
 		return "", nil, err
@@ -132,8 +141,8 @@ func GetECSContainerNetTxInBytesPanel(cmd *cobra.Command, clientAuth *model.Auth
 	return string(jsonString), cloudwatchMetricData, nil
 }
 
-func GetECSContainerNetTxInBytesMetricData(clientAuth *model.Auth, instanceID, elementType string, startTime, endTime *time.Time, statistic string, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
-	log.Printf("Getting metric data for instance %s in namespace %s from %v to %v", instanceID, elementType, startTime, endTime)
+func GetECSContainerNetTxInBytesMetricData(clientAuth *model.Auth, dimensions []*cloudwatch.Dimension, elementType string, startTime, endTime *time.Time, statistic string, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+	log.Printf("Getting metric data for dimensions %v in namespace %s from %v to %v", dimensions, elementType, startTime, endTime)
 
 	elmType := "ECS/ContainerInsights"
 
@@ -145,12 +154,7 @@ func GetECSContainerNetTxInBytesMetricData(clientAuth *model.Auth, instanceID, e
 				Id: aws.String("m1"),
 				MetricStat: &cloudwatch.MetricStat{
 					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ClusterName"),
-								Value: aws.String(instanceID),
-							},
-						},
+						Dimensions: dimensions,
 						MetricName: aws.String("NetworkTxBytes"),
 						Namespace:  aws.String(elmType),
 					},
@@ -202,6 +206,9 @@ func init() {
 	AwsxECSContainerNetTxInBytesCmd.PersistentFlags().String("externalId", "", "aws external id")
 	AwsxECSContainerNetTxInBytesCmd.PersistentFlags().String("cloudWatchQueries", "", "aws cloudwatch metric queries")
 	AwsxECSContainerNetTxInBytesCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxECSContainerNetTxInBytesCmd.PersistentFlags().String("ServiceName", "", "Service Name")
+	AwsxECSContainerNetTxInBytesCmd.PersistentFlags().String("TaskDefinitionFamily", "", "ECS task definition family")
+	AwsxECSContainerNetTxInBytesCmd.PersistentFlags().String("groupBy", "", "group series by. service")
 	AwsxECSContainerNetTxInBytesCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxECSContainerNetTxInBytesCmd.PersistentFlags().String("endTime", "", "endcl time")
 	AwsxECSContainerNetTxInBytesCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
@@ -112,8 +112,17 @@ func GetCPUReservationData(cmd *cobra.Command, clientAuth *model.Auth, cloudWatc
 
 	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
 
+	groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+	if groupBy == "service" {
+		return GetECSMetricByService(cmd, clientAuth, instanceId, "ECS/ContainerInsights", "Average", 60, []string{"CpuReserved"}, startTime, endTime, cloudWatchClient)
+	}
+	dimensions, err := GetECSDimensions(cmd, instanceId, "ECS/ContainerInsights")
+	if err != nil {
+		return "", nil, err
+	}
+
 	// Fetch raw data
-	rawData, err := GetCPUReservedMetricData(clientAuth, instanceId, elementType, startTime, endTime, cloudWatchClient)
+	rawData, err := GetCPUReservedMetricData(clientAuth, dimensions, elementType, startTime, endTime, cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting raw data: ", err)
 		return "", nil, err
@@ -132,7 +141,7 @@ func GetCPUReservationData(cmd *cobra.Command, clientAuth *model.Auth, cloudWatc
 	return string(jsonString), cloudwatchMetricData, nil
 }
 
-func GetCPUReservedMetricData(clientAuth *model.Auth, instanceId, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+func GetCPUReservedMetricData(clientAuth *model.Auth, dimensions []*cloudwatch.Dimension, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
 	elmType := "ECS/ContainerInsights"
 	input := &cloudwatch.GetMetricDataInput{
 		EndTime:   endTime,
@@ -142,12 +151,7 @@ func GetCPUReservedMetricData(clientAuth *model.Auth, instanceId, elementType st
 				Id: aws.String("m1"),
 				MetricStat: &cloudwatch.MetricStat{
 					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ClusterName"),
-								Value: aws.String(instanceId),
-							},
-						},
+						Dimensions: dimensions,
 						MetricName: aws.String("CpuReserved"),
 						Namespace:  aws.String(elmType),
 					},
@@ -197,6 +201,9 @@ func init() {
 	AwsxCpuReservedCmd.PersistentFlags().String("externalId", "", "aws external id")
 	AwsxCpuReservedCmd.PersistentFlags().String("cloudWatchQueries", "", "aws cloudwatch metric queries")
 	AwsxCpuReservedCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxCpuReservedCmd.PersistentFlags().String("ServiceName", "", "Service Name")
+	AwsxCpuReservedCmd.PersistentFlags().String("TaskDefinitionFamily", "", "ECS task definition family")
+	AwsxCpuReservedCmd.PersistentFlags().String("groupBy", "", "group series by. service")
 	AwsxCpuReservedCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxCpuReservedCmd.PersistentFlags().String("endTime", "", "endcl time")
 	AwsxCpuReservedCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
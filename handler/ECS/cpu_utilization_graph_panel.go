@@ -112,8 +112,17 @@ func GetCPUUtilizationGraphData(cmd *cobra.Command, clientAuth *model.Auth, clou
 
 	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
 
+	groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+	if groupBy == "service" {
+		return GetECSMetricByService(cmd, clientAuth, instanceId, "ECS/ContainerInsights", "Average", 60, []string{"CpuUtilized"}, startTime, endTime, cloudWatchClient)
+	}
+	dimensions, err := GetECSDimensions(cmd, instanceId, "ECS/ContainerInsights")
+	if err != nil {
+		return "", nil, err
+	}
+
 	// Fetch raw data
-	rawData, err := GetCPUUtilizationGraphMetricData(clientAuth, instanceId, elementType, startTime, endTime, cloudWatchClient)
+	rawData, err := GetCPUUtilizationGraphMetricData(clientAuth, dimensions, elementType, startTime, endTime, cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting raw data: ", err)
 		return "", nil, err
@@ -132,7 +141,7 @@ func GetCPUUtilizationGraphData(cmd *cobra.Command, clientAuth *model.Auth, clou
 	return string(jsonString), cloudwatchMetricData, nil
 }
 
-func GetCPUUtilizationGraphMetricData(clientAuth *model.Auth, instanceId, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+func GetCPUUtilizationGraphMetricData(clientAuth *model.Auth, dimensions []*cloudwatch.Dimension, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
 
 	elmType := "ECS/ContainerInsights"
 	input := &cloudwatch.GetMetricDataInput{
@@ -143,12 +152,7 @@ func GetCPUUtilizationGraphMetricData(clientAuth *model.Auth, instanceId, elemen
 				Id: aws.String("m1"),
 				MetricStat: &cloudwatch.MetricStat{
 					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ClusterName"),
-								Value: aws.String(instanceId),
-							},
-						},
+						Dimensions: dimensions,
 						MetricName: aws.String("CpuUtilized"),
 						Namespace:  aws.String(elmType),
 					},
@@ -199,6 +203,9 @@ func init() {
 	AwsxECSCpuUtilizationGraphCmd.PersistentFlags().String("externalId", "", "aws external id")
 	AwsxECSCpuUtilizationGraphCmd.PersistentFlags().String("cloudWatchQueries", "", "aws cloudwatch metric queries")
 	AwsxECSCpuUtilizationGraphCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxECSCpuUtilizationGraphCmd.PersistentFlags().String("ServiceName", "", "Service Name")
+	AwsxECSCpuUtilizationGraphCmd.PersistentFlags().String("TaskDefinitionFamily", "", "ECS task definition family")
+	AwsxECSCpuUtilizationGraphCmd.PersistentFlags().String("groupBy", "", "group series by. service")
 	AwsxECSCpuUtilizationGraphCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxECSCpuUtilizationGraphCmd.PersistentFlags().String("endTime", "", "endcl time")
 	AwsxECSCpuUtilizationGraphCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
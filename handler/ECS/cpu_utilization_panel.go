@@ -116,22 +116,31 @@ func GetECScpuUtilizationPanel(cmd *cobra.Command, clientAuth *model.Auth, cloud
 		endTime = &defaultEndTime
 	}
 	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
+	groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+	if groupBy == "service" {
+		return GetECSMetricByService(cmd, clientAuth, instanceId, "ECS/ContainerInsights", "Average", 300, []string{"CpuUtilized"}, startTime, endTime, cloudWatchClient)
+	}
+	dimensions, err := GetECSDimensions(cmd, instanceId, "ECS/ContainerInsights")
+	if err != nil {
+		return "", nil, err
+	}
+
 	//if queryName == "cpu_utilization_panel" {
-	currentUsage, err := GetECSCpuUtilizationMetricData(clientAuth, instanceId, elementType, startTime, endTime, "SampleCount", cloudWatchClient)
+	currentUsage, err := GetECSCpuUtilizationMetricData(clientAuth, dimensions, elementType, startTime, endTime, "SampleCount", cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting sample count: ", err)
 		return "", nil, err
 	}
 	cloudwatchMetricData["CurrentUsage"] = currentUsage
 	// Get average usage
-	averageUsage, err := GetECSCpuUtilizationMetricData(clientAuth, instanceId, elementType, startTime, endTime, "Average", cloudWatchClient)
+	averageUsage, err := GetECSCpuUtilizationMetricData(clientAuth, dimensions, elementType, startTime, endTime, "Average", cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting average: ", err)
 		return "", nil, err
 	}
 	cloudwatchMetricData["AverageUsage"] = averageUsage
 	// Get max usage
-	maxUsage, err := GetECSCpuUtilizationMetricData(clientAuth, instanceId, elementType, startTime, endTime, "Maximum", cloudWatchClient)
+	maxUsage, err := GetECSCpuUtilizationMetricData(clientAuth, dimensions, elementType, startTime, endTime, "Maximum", cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting maximum: ", err)
 		return "", nil, err
@@ -153,7 +162,7 @@ func GetECScpuUtilizationPanel(cmd *cobra.Command, clientAuth *model.Auth, cloud
 
 }
 
-func GetECSCpuUtilizationMetricData(clientAuth *model.Auth, instanceID, elementType string, startTime, endTime *time.Time, statistic string, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+func GetECSCpuUtilizationMetricData(clientAuth *model.Auth, dimensions []*cloudwatch.Dimension, elementType string, startTime, endTime *time.Time, statistic string, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
 	elmType := "ECS/ContainerInsights"
 	input := &cloudwatch.GetMetricDataInput{
 		EndTime:   endTime,
@@ -163,12 +172,7 @@ func GetECSCpuUtilizationMetricData(clientAuth *model.Auth, instanceID, elementT
 				Id: aws.String("m1"),
 				MetricStat: &cloudwatch.MetricStat{
 					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ClusterName"),
-								Value: aws.String(instanceID),
-							},
-						},
+						Dimensions: dimensions,
 						MetricName: aws.String("CpuUtilized"),
 						Namespace:  aws.String(elmType),
 					},
@@ -203,6 +207,9 @@ func init() {
 	AwsxECSCpuUtilizationCmd.PersistentFlags().String("externalId", "", "aws external id")
 	AwsxECSCpuUtilizationCmd.PersistentFlags().String("cloudWatchQueries", "", "aws cloudwatch metric queries")
 	AwsxECSCpuUtilizationCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxECSCpuUtilizationCmd.PersistentFlags().String("ServiceName", "", "Service Name")
+	AwsxECSCpuUtilizationCmd.PersistentFlags().String("TaskDefinitionFamily", "", "ECS task definition family")
+	AwsxECSCpuUtilizationCmd.PersistentFlags().String("groupBy", "", "group series by. service")
 	AwsxECSCpuUtilizationCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxECSCpuUtilizationCmd.PersistentFlags().String("endTime", "", "endcl time")
 	AwsxECSCpuUtilizationCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
@@ -0,0 +1,67 @@
+package ECS
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Alarm"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var AwsxECSMeanTimeToRecoveryCmd = &cobra.Command{
+	Use:   "mean_time_to_recovery_panel",
+	Short: "get mean time to recovery data",
+	Long:  `command to get mean time to recovery data`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, recoveryStats, err := GetECSMeanTimeToRecoveryPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting mean time to recovery: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(recoveryStats)
+			} else {
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetECSMeanTimeToRecoveryPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, *Alarm.RecoveryStats, error) {
+	return Alarm.GetMeanTimeToRecoveryPanel(cmd, clientAuth, "ECS", nil, cloudWatchClient)
+}
+
+func init() {
+	AwsxECSMeanTimeToRecoveryCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxECSMeanTimeToRecoveryCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxECSMeanTimeToRecoveryCmd.PersistentFlags().String("query", "", "query")
+	AwsxECSMeanTimeToRecoveryCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxECSMeanTimeToRecoveryCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxECSMeanTimeToRecoveryCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxECSMeanTimeToRecoveryCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxECSMeanTimeToRecoveryCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxECSMeanTimeToRecoveryCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxECSMeanTimeToRecoveryCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxECSMeanTimeToRecoveryCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxECSMeanTimeToRecoveryCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxECSMeanTimeToRecoveryCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxECSMeanTimeToRecoveryCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxECSMeanTimeToRecoveryCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -112,8 +112,17 @@ func GetMemoryReservationData(cmd *cobra.Command, clientAuth *model.Auth, cloudW
 
 	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
 
+	groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+	if groupBy == "service" {
+		return GetECSMetricByService(cmd, clientAuth, instanceId, "ECS/ContainerInsights", "Average", 60, []string{"MemoryReserved"}, startTime, endTime, cloudWatchClient)
+	}
+	dimensions, err := GetECSDimensions(cmd, instanceId, "ECS/ContainerInsights")
+	if err != nil {
+		return "", nil, err
+	}
+
 	// Fetch raw data
-	rawData, err := GetMemoryReservedMetricData(clientAuth, instanceId, elementType, startTime, endTime, cloudWatchClient)
+	rawData, err := GetMemoryReservedMetricData(clientAuth, dimensions, elementType, startTime, endTime, cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting raw data: ", err)
 		return "", nil, err
@@ -132,7 +141,7 @@ func GetMemoryReservationData(cmd *cobra.Command, clientAuth *model.Auth, cloudW
 	return string(jsonString), cloudwatchMetricData, nil
 }
 
-func GetMemoryReservedMetricData(clientAuth *model.Auth, instanceId, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+func GetMemoryReservedMetricData(clientAuth *model.Auth, dimensions []*cloudwatch.Dimension, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
 	elmType := "ECS/ContainerInsights"
 	input := &cloudwatch.GetMetricDataInput{
 		EndTime:   endTime,
@@ -142,12 +151,7 @@ func GetMemoryReservedMetricData(clientAuth *model.Auth, instanceId, elementType
 				Id: aws.String("m1"),
 				MetricStat: &cloudwatch.MetricStat{
 					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ClusterName"),
-								Value: aws.String(instanceId),
-							},
-						},
+						Dimensions: dimensions,
 						MetricName: aws.String("MemoryReserved"),
 						Namespace:  aws.String(elmType),
 					},
@@ -197,6 +201,9 @@ func init() {
 	AwsxMemoryReservedCmd.PersistentFlags().String("externalId", "", "aws external id")
 	AwsxMemoryReservedCmd.PersistentFlags().String("cloudWatchQueries", "", "aws cloudwatch metric queries")
 	AwsxMemoryReservedCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxMemoryReservedCmd.PersistentFlags().String("ServiceName", "", "Service Name")
+	AwsxMemoryReservedCmd.PersistentFlags().String("TaskDefinitionFamily", "", "ECS task definition family")
+	AwsxMemoryReservedCmd.PersistentFlags().String("groupBy", "", "group series by. service")
 	AwsxMemoryReservedCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxMemoryReservedCmd.PersistentFlags().String("endTime", "", "endcl time")
 	AwsxMemoryReservedCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
@@ -113,8 +113,17 @@ func GetMemoryUtilizationGraphData(cmd *cobra.Command, clientAuth *model.Auth, c
 
     cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
 
+    groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+    if groupBy == "service" {
+        return GetECSMetricByService(cmd, clientAuth, instanceId, "ECS/ContainerInsights", "Average", 60, []string{"MemoryUtilized"}, startTime, endTime, cloudWatchClient)
+    }
+    dimensions, err := GetECSDimensions(cmd, instanceId, "ECS/ContainerInsights")
+    if err != nil {
+        return "", nil, err
+    }
+
     // Fetch raw data
-    rawData, err := GetMemoryUtilizationGraphMetricData(clientAuth, instanceId, elementType, startTime, endTime, cloudWatchClient)
+    rawData, err := GetMemoryUtilizationGraphMetricData(clientAuth, dimensions, elementType, startTime, endTime, cloudWatchClient)
     if err != nil {
         log.Println("Error in getting raw data: ", err)
         return "", nil, err
@@ -132,7 +141,7 @@ func GetMemoryUtilizationGraphData(cmd *cobra.Command, clientAuth *model.Auth, c
     return string(jsonString), cloudwatchMetricData, nil
 }
 
-func GetMemoryUtilizationGraphMetricData(clientAuth *model.Auth, instanceId, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+func GetMemoryUtilizationGraphMetricData(clientAuth *model.Auth, dimensions []*cloudwatch.Dimension, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
 
     elmType := "ECS/ContainerInsights"
     input := &cloudwatch.GetMetricDataInput{
@@ -143,12 +152,7 @@ func GetMemoryUtilizationGraphMetricData(clientAuth *model.Auth, instanceId, ele
                 Id: aws.String("m1"),
                 MetricStat: &cloudwatch.MetricStat{
                     Metric: &cloudwatch.Metric{
-                        Dimensions: []*cloudwatch.Dimension{
-                            {
-                                Name:  aws.String("ClusterName"),
-                                Value: aws.String(instanceId),
-                            },
-                        },
+                        Dimensions: dimensions,
                         MetricName: aws.String("MemoryUtilized"),
                         Namespace:  aws.String(elmType),
                     },
@@ -200,6 +204,9 @@ func init() {
     AwsxECSMemoryUtilizationGraphCmd.PersistentFlags().String("externalId", "", "aws external id")
     AwsxECSMemoryUtilizationGraphCmd.PersistentFlags().String("cloudWatchQueries", "", "aws cloudwatch metric queries")
     AwsxECSMemoryUtilizationGraphCmd.PersistentFlags().String("instanceId", "", "instance id")
+    AwsxECSMemoryUtilizationGraphCmd.PersistentFlags().String("ServiceName", "", "Service Name")
+    AwsxECSMemoryUtilizationGraphCmd.PersistentFlags().String("TaskDefinitionFamily", "", "ECS task definition family")
+    AwsxECSMemoryUtilizationGraphCmd.PersistentFlags().String("groupBy", "", "group series by. service")
     AwsxECSMemoryUtilizationGraphCmd.PersistentFlags().String("startTime", "", "start time")
     AwsxECSMemoryUtilizationGraphCmd.PersistentFlags().String("endTime", "", "endcl time")
     AwsxECSMemoryUtilizationGraphCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
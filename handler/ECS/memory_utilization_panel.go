@@ -114,19 +114,28 @@ func GetECSMemoryUtilizationPanel(cmd *cobra.Command, clientAuth *model.Auth, cl
 		endTime = &defaultEndTime
 	}
 	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
-	currentUsage, err := GetECSContainerMetricData(clientAuth, instanceId, elementType, startTime, endTime, "SampleCount", cloudWatchClient)
+	groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+	if groupBy == "service" {
+		return GetECSMetricByService(cmd, clientAuth, instanceId, "AWS/ECS", "Average", 300, []string{"MemoryUtilization"}, startTime, endTime, cloudWatchClient)
+	}
+	dimensions, err := GetECSDimensions(cmd, instanceId, "AWS/ECS")
+	if err != nil {
+		return "", nil, err
+	}
+
+	currentUsage, err := GetECSContainerMetricData(clientAuth, dimensions, elementType, startTime, endTime, "SampleCount", cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting sample count: ", err)
 		return "", nil, err
 	}
 	cloudwatchMetricData["CurrentUsage"] = currentUsage
-	averageUsage, err := GetECSContainerMetricData(clientAuth, instanceId, elementType, startTime, endTime, "Average", cloudWatchClient)
+	averageUsage, err := GetECSContainerMetricData(clientAuth, dimensions, elementType, startTime, endTime, "Average", cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting average: ", err)
 		return "", nil, err
 	}
 	cloudwatchMetricData["AverageUsage"] = averageUsage
-	maxUsage, err := GetECSContainerMetricData(clientAuth, instanceId, elementType, startTime, endTime, "Maximum", cloudWatchClient)
+	maxUsage, err := GetECSContainerMetricData(clientAuth, dimensions, elementType, startTime, endTime, "Maximum", cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting maximum: ", err)
 		return "", nil, err
@@ -148,7 +157,7 @@ func GetECSMemoryUtilizationPanel(cmd *cobra.Command, clientAuth *model.Auth, cl
 
 }
 
-func GetECSContainerMetricData(clientAuth *model.Auth, instanceId, elementType string, startTime, endTime *time.Time, statistic string, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+func GetECSContainerMetricData(clientAuth *model.Auth, dimensions []*cloudwatch.Dimension, elementType string, startTime, endTime *time.Time, statistic string, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
 	elmType := "AWS/ECS"
 	input := &cloudwatch.GetMetricDataInput{
 		EndTime:   endTime,
@@ -158,12 +167,7 @@ func GetECSContainerMetricData(clientAuth *model.Auth, instanceId, elementType s
 				Id: aws.String("m1"),
 				MetricStat: &cloudwatch.MetricStat{
 					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ClusterName"),
-								Value: aws.String(instanceId),
-							},
-						},
+						Dimensions: dimensions,
 						MetricName: aws.String("MemoryUtilization"),
 						Namespace:  aws.String(elmType),
 					},
@@ -198,6 +202,9 @@ func init() {
 	AwsxECSMemoryUtilizationCmd.PersistentFlags().String("externalId", "", "aws external id")
 	AwsxECSMemoryUtilizationCmd.PersistentFlags().String("cloudWatchQueries", "", "aws cloudwatch metric queries")
 	AwsxECSMemoryUtilizationCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxECSMemoryUtilizationCmd.PersistentFlags().String("ServiceName", "", "Service Name")
+	AwsxECSMemoryUtilizationCmd.PersistentFlags().String("TaskDefinitionFamily", "", "ECS task definition family")
+	AwsxECSMemoryUtilizationCmd.PersistentFlags().String("groupBy", "", "group series by. service")
 	AwsxECSMemoryUtilizationCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxECSMemoryUtilizationCmd.PersistentFlags().String("endTime", "", "endcl time")
 	AwsxECSMemoryUtilizationCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
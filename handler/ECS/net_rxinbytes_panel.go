@@ -113,8 +113,17 @@ func GetECSNetworkRxInBytesPanel(cmd *cobra.Command, clientAuth *model.Auth, clo
 
 	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
 
+	groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+	if groupBy == "service" {
+		return GetECSMetricByService(cmd, clientAuth, instanceId, "ECS/ContainerInsights", "Sum", 60, []string{"NetworkRxBytes"}, startTime, endTime, cloudWatchClient)
+	}
+	dimensions, err := GetECSDimensions(cmd, instanceId, "ECS/ContainerInsights")
+	if err != nil {
+		return "", nil, err
+	}
+
 	// Fetch raw data
-	rawData, err := GetECSNetworkRxInBytesMetricData(clientAuth, instanceId, elementType, startTime, endTime, "Sum", cloudWatchClient)
+	rawData, err := GetECSNetworkRxInBytesMetricData(clientAuth, dimensions, elementType, startTime, endTime, "Sum", cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting raw data: ", err)
 		return "", nil, err
@@ -133,8 +142,8 @@ func GetECSNetworkRxInBytesPanel(cmd *cobra.Command, clientAuth *model.Auth, clo
 }
 
 
-func GetECSNetworkRxInBytesMetricData(clientAuth *model.Auth, instanceID, elementType string, startTime, endTime *time.Time, statistic string, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
-	log.Printf("Getting metric data for instance %s in namespace %s from %v to %v", instanceID, elementType, startTime, endTime)
+func GetECSNetworkRxInBytesMetricData(clientAuth *model.Auth, dimensions []*cloudwatch.Dimension, elementType string, startTime, endTime *time.Time, statistic string, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+	log.Printf("Getting metric data for dimensions %v in namespace %s from %v to %v", dimensions, elementType, startTime, endTime)
 
 	elmType := "ECS/ContainerInsights"
 	if elementType == "ECS" {
@@ -148,12 +157,7 @@ func GetECSNetworkRxInBytesMetricData(clientAuth *model.Auth, instanceID, elemen
 				Id: aws.String("m1"),
 				MetricStat: &cloudwatch.MetricStat{
 					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ClusterName"),
-								Value: aws.String(instanceID),
-							},
-						},
+						Dimensions: dimensions,
 						MetricName: aws.String("NetworkRxBytes"),
 						Namespace:  aws.String(elmType),
 					},
@@ -204,6 +208,9 @@ func init() {
 	AwsxECSNetworkRxInBytesCmd.PersistentFlags().String("externalId", "", "aws external id")
 	AwsxECSNetworkRxInBytesCmd.PersistentFlags().String("cloudWatchQueries", "", "aws cloudwatch metric queries")
 	AwsxECSNetworkRxInBytesCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxECSNetworkRxInBytesCmd.PersistentFlags().String("ServiceName", "", "Service Name")
+	AwsxECSNetworkRxInBytesCmd.PersistentFlags().String("TaskDefinitionFamily", "", "ECS task definition family")
+	AwsxECSNetworkRxInBytesCmd.PersistentFlags().String("groupBy", "", "group series by. service")
 	AwsxECSNetworkRxInBytesCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxECSNetworkRxInBytesCmd.PersistentFlags().String("endTime", "", "endcl time")
 	AwsxECSNetworkRxInBytesCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
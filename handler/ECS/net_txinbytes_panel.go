@@ -113,8 +113,17 @@ func GetECSNetworkTxInBytesPanel(cmd *cobra.Command, clientAuth *model.Auth, clo
 
 	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
 
+	groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+	if groupBy == "service" {
+		return GetECSMetricByService(cmd, clientAuth, instanceId, "ECS/ContainerInsights", "Sum", 60, []string{"NetworkTxBytes"}, startTime, endTime, cloudWatchClient)
+	}
+	dimensions, err := GetECSDimensions(cmd, instanceId, "ECS/ContainerInsights")
+	if err != nil {
+		return "", nil, err
+	}
+
 	// Fetch raw data
-	rawData, err := GetECSNetworkTxInBytesMetricData(clientAuth, instanceId, elementType, startTime, endTime, "Sum", cloudWatchClient)
+	rawData, err := GetECSNetworkTxInBytesMetricData(clientAuth, dimensions, elementType, startTime, endTime, "Sum", cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting raw data: ", err)
 		return "", nil, err
@@ -133,8 +142,8 @@ func GetECSNetworkTxInBytesPanel(cmd *cobra.Command, clientAuth *model.Auth, clo
 }
 
 
-func GetECSNetworkTxInBytesMetricData(clientAuth *model.Auth, instanceID, elementType string, startTime, endTime *time.Time, statistic string, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
-	log.Printf("Getting metric data for instance %s in namespace %s from %v to %v", instanceID, elementType, startTime, endTime)
+func GetECSNetworkTxInBytesMetricData(clientAuth *model.Auth, dimensions []*cloudwatch.Dimension, elementType string, startTime, endTime *time.Time, statistic string, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+	log.Printf("Getting metric data for dimensions %v in namespace %s from %v to %v", dimensions, elementType, startTime, endTime)
 
 	elmType := "ECS/ContainerInsights"
 	if elementType == "ECS" {
@@ -148,12 +157,7 @@ func GetECSNetworkTxInBytesMetricData(clientAuth *model.Auth, instanceID, elemen
 				Id: aws.String("m1"),
 				MetricStat: &cloudwatch.MetricStat{
 					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ClusterName"),
-								Value: aws.String(instanceID),
-							},
-						},
+						Dimensions: dimensions,
 						MetricName: aws.String("NetworkTxBytes"),
 						Namespace:  aws.String(elmType),
 					},
@@ -204,6 +208,9 @@ func init() {
 	AwsxECSNetworkTxInBytesCmd.PersistentFlags().String("externalId", "", "aws external id")
 	AwsxECSNetworkTxInBytesCmd.PersistentFlags().String("cloudWatchQueries", "", "aws cloudwatch metric queries")
 	AwsxECSNetworkTxInBytesCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxECSNetworkTxInBytesCmd.PersistentFlags().String("ServiceName", "", "Service Name")
+	AwsxECSNetworkTxInBytesCmd.PersistentFlags().String("TaskDefinitionFamily", "", "ECS task definition family")
+	AwsxECSNetworkTxInBytesCmd.PersistentFlags().String("groupBy", "", "group series by. service")
 	AwsxECSNetworkTxInBytesCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxECSNetworkTxInBytesCmd.PersistentFlags().String("endTime", "", "endcl time")
 	AwsxECSNetworkTxInBytesCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
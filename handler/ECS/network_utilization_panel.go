@@ -116,8 +116,17 @@ func GetNetworkUtilizationPanel(cmd *cobra.Command, clientAuth *model.Auth, clou
 
 	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
 
+	groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+	if groupBy == "service" {
+		return GetECSMetricByService(cmd, clientAuth, instanceId, "ECS/ContainerInsights", "Sum", 300, []string{"NetworkRxBytes", "NetworkTxBytes"}, startTime, endTime, cloudWatchClient)
+	}
+	dimensions, err := GetECSDimensions(cmd, instanceId, "ECS/ContainerInsights")
+	if err != nil {
+		return "", nil, err
+	}
+
 	// Get Inbound Traffic
-	inboundTraffic, err := GetNetworkMetricData(clientAuth, instanceId, elementType, startTime, endTime, "NetworkRxBytes", cloudWatchClient)
+	inboundTraffic, err := GetNetworkMetricData(clientAuth, dimensions, elementType, startTime, endTime, "NetworkRxBytes", cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting inbound traffic: ", err)
 		return "", nil, err
@@ -125,7 +134,7 @@ func GetNetworkUtilizationPanel(cmd *cobra.Command, clientAuth *model.Auth, clou
 	cloudwatchMetricData["InboundTraffic"] = inboundTraffic
 
 	// Get Outbound Traffic
-	outboundTraffic, err := GetNetworkMetricData(clientAuth, instanceId, elementType, startTime, endTime, "NetworkTxBytes", cloudWatchClient)
+	outboundTraffic, err := GetNetworkMetricData(clientAuth, dimensions, elementType, startTime, endTime, "NetworkTxBytes", cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting outbound traffic: ", err)
 		return "", nil, err
@@ -151,7 +160,7 @@ func GetNetworkUtilizationPanel(cmd *cobra.Command, clientAuth *model.Auth, clou
 	return string(jsonString), cloudwatchMetricData, nil
 }
 
-func GetNetworkMetricData(clientAuth *model.Auth, instanceId, elementType string, startTime, endTime *time.Time, metricName string, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+func GetNetworkMetricData(clientAuth *model.Auth, dimensions []*cloudwatch.Dimension, elementType string, startTime, endTime *time.Time, metricName string, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
 	elmType := "ECS/ContainerInsights"
 	input := &cloudwatch.GetMetricDataInput{
 		EndTime:   endTime,
@@ -161,12 +170,7 @@ func GetNetworkMetricData(clientAuth *model.Auth, instanceId, elementType string
 				Id: aws.String("m1"),
 				MetricStat: &cloudwatch.MetricStat{
 					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ClusterName"),
-								Value: aws.String(instanceId),
-							},
-						},
+						Dimensions: dimensions,
 						MetricName: aws.String(metricName),
 						Namespace:  aws.String(elmType),
 					},
@@ -218,6 +222,9 @@ func init() {
 	AwsxECSNetworkUtilizationCmd.PersistentFlags().String("externalId", "", "aws external id")
 	AwsxECSNetworkUtilizationCmd.PersistentFlags().String("cloudWatchQueries", "", "aws cloudwatch metric queries")
 	AwsxECSNetworkUtilizationCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxECSNetworkUtilizationCmd.PersistentFlags().String("ServiceName", "", "Service Name")
+	AwsxECSNetworkUtilizationCmd.PersistentFlags().String("TaskDefinitionFamily", "", "ECS task definition family")
+	AwsxECSNetworkUtilizationCmd.PersistentFlags().String("groupBy", "", "group series by. service")
 	AwsxECSNetworkUtilizationCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxECSNetworkUtilizationCmd.PersistentFlags().String("endTime", "", "endcl time")
 	AwsxECSNetworkUtilizationCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
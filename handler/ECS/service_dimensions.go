@@ -0,0 +1,123 @@
+package ECS
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/spf13/cobra"
+)
+
+// GetECSDimensions returns the dimensions for the cluster, narrowed to a service or a
+// task definition family when the ServiceName or TaskDefinitionFamily flag is set.
+// Container Insights publishes ClusterName+ServiceName and ClusterName+TaskDefinitionFamily
+// but not both together, and AWS/ECS has no TaskDefinitionFamily dimension.
+func GetECSDimensions(cmd *cobra.Command, clusterName, namespace string) ([]*cloudwatch.Dimension, error) {
+	serviceName, _ := cmd.PersistentFlags().GetString("ServiceName")
+	taskDefinitionFamily, _ := cmd.PersistentFlags().GetString("TaskDefinitionFamily")
+
+	if serviceName != "" && taskDefinitionFamily != "" {
+		return nil, errors.New("ServiceName and TaskDefinitionFamily cannot be combined")
+	}
+	if taskDefinitionFamily != "" && namespace == "AWS/ECS" {
+		return nil, errors.New("TaskDefinitionFamily is not a dimension of AWS/ECS metrics")
+	}
+
+	dimensions := []*cloudwatch.Dimension{
+		{
+			Name:  aws.String("ClusterName"),
+			Value: aws.String(clusterName),
+		},
+	}
+	if serviceName != "" {
+		dimensions = append(dimensions, &cloudwatch.Dimension{
+			Name:  aws.String("ServiceName"),
+			Value: aws.String(serviceName),
+		})
+	}
+	if taskDefinitionFamily != "" {
+		dimensions = append(dimensions, &cloudwatch.Dimension{
+			Name:  aws.String("TaskDefinitionFamily"),
+			Value: aws.String(taskDefinitionFamily),
+		})
+	}
+	return dimensions, nil
+}
+
+// ListClusterServices returns the names of all services in the cluster.
+func ListClusterServices(clientAuth *model.Auth, clusterName string) ([]string, error) {
+	ecsClient := awsclient.GetClient(*clientAuth, awsclient.ECS_CLIENT).(*ecs.ECS)
+
+	var serviceNames []string
+	input := &ecs.ListServicesInput{
+		Cluster: aws.String(clusterName),
+	}
+	err := ecsClient.ListServicesPages(input, func(page *ecs.ListServicesOutput, lastPage bool) bool {
+		for _, serviceArn := range page.ServiceArns {
+			// arn:aws:ecs:region:account:service/cluster-name/service-name
+			arn := aws.StringValue(serviceArn)
+			serviceNames = append(serviceNames, arn[strings.LastIndex(arn, "/")+1:])
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return serviceNames, nil
+}
+
+// GetECSMetricByService returns one series per service in the cluster for each of the
+// given metrics, keyed by service and then metric name. The services are discovered
+// through ListServices unless the ServiceName flag names one. TaskDefinitionFamily is
+// rejected, as no metric carries both it and ServiceName.
+func GetECSMetricByService(cmd *cobra.Command, clientAuth *model.Auth, clusterName, namespace, statistic string, period int64, metricNames []string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	serviceName, _ := cmd.PersistentFlags().GetString("ServiceName")
+	taskDefinitionFamily, _ := cmd.PersistentFlags().GetString("TaskDefinitionFamily")
+
+	if taskDefinitionFamily != "" {
+		return "", nil, errors.New("TaskDefinitionFamily cannot be combined with groupBy service")
+	}
+
+	serviceNames := []string{serviceName}
+	if serviceName == "" {
+		var err error
+		serviceNames, err = ListClusterServices(clientAuth, clusterName)
+		if err != nil {
+			log.Println("Error listing cluster services: ", err)
+			return "", nil, err
+		}
+	}
+
+	serviceDimensions := map[string][]*cloudwatch.Dimension{}
+	for _, serviceName := range serviceNames {
+		serviceDimensions[serviceName] = append(Metric.Dimensions("ClusterName", clusterName), &cloudwatch.Dimension{
+			Name:  aws.String("ServiceName"),
+			Value: aws.String(serviceName),
+		})
+	}
+	var series []Metric.Series
+	for _, metricName := range metricNames {
+		series = append(series, Metric.Series{Label: metricName, MetricName: metricName, Statistic: statistic})
+	}
+
+	result, cloudwatchMetricData, err := Metric.GetEntityMetricData(clientAuth, namespace, period, serviceDimensions, series, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting service metric data: ", err)
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+	return string(jsonString), cloudwatchMetricData, nil
+}
@@ -120,8 +120,17 @@ func GetStorageUtilizationPanel(cmd *cobra.Command, clientAuth *model.Auth, clou
 
 	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
 
+	groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+	if groupBy == "service" {
+		return GetECSMetricByService(cmd, clientAuth, instanceId, "ECS/ContainerInsights", "Average", 300, []string{"EphemeralStorageUtilized"}, startTime, endTime, cloudWatchClient)
+	}
+	dimensions, err := GetECSDimensions(cmd, instanceId, "ECS/ContainerInsights")
+	if err != nil {
+		return "", nil, err
+	}
+
 	// Get Root Volume Utilization
-	rootVolumeUsage, err := GetStorageMetricData(clientAuth, instanceId, elementType, startTime, endTime, "Average", "EphemeralStorageUtilized", cloudWatchClient)
+	rootVolumeUsage, err := GetStorageMetricData(clientAuth, dimensions, elementType, startTime, endTime, "Average", "EphemeralStorageUtilized", cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting root volume usage: ", err)
 		return "", nil, err
@@ -129,7 +138,7 @@ func GetStorageUtilizationPanel(cmd *cobra.Command, clientAuth *model.Auth, clou
 	cloudwatchMetricData["RootVolumeUtilization"] = rootVolumeUsage
 
 	// Get EBS1 Volume  Utilization
-	ebs1VolumeUsage, err := GetStorageMetricData(clientAuth, instanceId, elementType, startTime, endTime, "Average", "EphemeralStorageUtilized", cloudWatchClient)
+	ebs1VolumeUsage, err := GetStorageMetricData(clientAuth, dimensions, elementType, startTime, endTime, "Average", "EphemeralStorageUtilized", cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting EBS1 Volume Utilization : ", err)
 		return "", nil, err
@@ -137,7 +146,7 @@ func GetStorageUtilizationPanel(cmd *cobra.Command, clientAuth *model.Auth, clou
 	cloudwatchMetricData["EBS1Volume1Utilization"] = ebs1VolumeUsage
 
 	// Get EBS2 Volume Utilization
-	ebs2VolumeUsage, err := GetStorageMetricData(clientAuth, instanceId, elementType, startTime, endTime, "Average", "EphemeralStorageUtilized", cloudWatchClient)
+	ebs2VolumeUsage, err := GetStorageMetricData(clientAuth, dimensions, elementType, startTime, endTime, "Average", "EphemeralStorageUtilized", cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting EBS2 volume 2 usage: ", err)
 		return "", nil, err
@@ -185,8 +194,8 @@ func GetStorageUtilizationPanel(cmd *cobra.Command, clientAuth *model.Auth, clou
 	return string(jsonString), cloudwatchMetricData, nil
 }
 
-func GetStorageMetricData(clientAuth *model.Auth, instanceId, elementType string, startTime, endTime *time.Time, statistic, metricName string, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
-	//log.Printf("Getting metric data for instance %s in namespace %s from %v to %v", instanceID, elementType, startTime, endTime)
+func GetStorageMetricData(clientAuth *model.Auth, dimensions []*cloudwatch.Dimension, elementType string, startTime, endTime *time.Time, statistic, metricName string, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+	//log.Printf("Getting metric data for dimensions %v in namespace %s from %v to %v", dimensions, elementType, startTime, endTime)
 
 	elmType := "ECS/ContainerInsights"
 	input := &cloudwatch.GetMetricDataInput{
@@ -197,13 +206,7 @@ func GetStorageMetricData(clientAuth *model.Auth, instanceId, elementType string
 				Id: aws.String("m1"),
 				MetricStat: &cloudwatch.MetricStat{
 					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ClusterName"),
-								Value: aws.String(instanceId),
-							},
-							// Add dimensions for specific EBS volumes if needed
-						},
+						Dimensions: dimensions,
 						MetricName: aws.String(metricName),
 						Namespace:  aws.String(elmType),
 					},
@@ -249,6 +252,9 @@ func init() {
 	AwsxECSStorageUtilizationCmd.PersistentFlags().String("externalId", "", "aws external id")
 	AwsxECSStorageUtilizationCmd.PersistentFlags().String("cloudWatchQueries", "", "aws cloudwatch metric queries")
 	AwsxECSStorageUtilizationCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxECSStorageUtilizationCmd.PersistentFlags().String("ServiceName", "", "Service Name")
+	AwsxECSStorageUtilizationCmd.PersistentFlags().String("TaskDefinitionFamily", "", "ECS task definition family")
+	AwsxECSStorageUtilizationCmd.PersistentFlags().String("groupBy", "", "group series by. service")
 	AwsxECSStorageUtilizationCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxECSStorageUtilizationCmd.PersistentFlags().String("endTime", "", "endcl time")
 	AwsxECSStorageUtilizationCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
@@ -112,8 +112,17 @@ func GetECSReadBytesPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatch
 
 	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
 
+	groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+	if groupBy == "service" {
+		return GetECSMetricByService(cmd, clientAuth, instanceId, "ECS/ContainerInsights", "Sum", 60, []string{"StorageReadBytes"}, startTime, endTime, cloudWatchClient)
+	}
+	dimensions, err := GetECSDimensions(cmd, instanceId, "ECS/ContainerInsights")
+	if err != nil {
+		return "", nil, err
+	}
+
 	// Fetch raw data
-	rawData, err := GetECSReadBytesMetricData(clientAuth, instanceId, elementType, startTime, endTime, "Sum", cloudWatchClient)
+	rawData, err := GetECSReadBytesMetricData(clientAuth, dimensions, elementType, startTime, endTime, "Sum", cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting raw data: ", err)
 		return "", nil, err
@@ -131,8 +140,8 @@ func GetECSReadBytesPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatch
 	return string(jsonString), cloudwatchMetricData, nil
 }
 
-func GetECSReadBytesMetricData(clientAuth *model.Auth, instanceID, elementType string, startTime, endTime *time.Time, statistic string, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
-	log.Printf("Getting metric data for instance %s in namespace %s from %v to %v", instanceID, elementType, startTime, endTime)
+func GetECSReadBytesMetricData(clientAuth *model.Auth, dimensions []*cloudwatch.Dimension, elementType string, startTime, endTime *time.Time, statistic string, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+	log.Printf("Getting metric data for dimensions %v in namespace %s from %v to %v", dimensions, elementType, startTime, endTime)
 
 	elmType := "ECS/ContainerInsights"
 	if elementType == "ECS" {
@@ -146,12 +155,7 @@ func GetECSReadBytesMetricData(clientAuth *model.Auth, instanceID, elementType s
 				Id: aws.String("m1"),
 				MetricStat: &cloudwatch.MetricStat{
 					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ClusterName"),
-								Value: aws.String(instanceID),
-							},
-						},
+						Dimensions: dimensions,
 						MetricName: aws.String("StorageReadBytes"),
 						Namespace:  aws.String(elmType),
 					},
@@ -161,8 +165,6 @@ func GetECSReadBytesMetricData(clientAuth *model.Auth, instanceID, elementType s
 			},
 		},
 	}
-	fmt.Println(instanceID)
-
 	if cloudWatchClient == nil {
 		cloudWatchClient = awsclient.GetClient(*clientAuth, awsclient.CLOUDWATCH).(*cloudwatch.CloudWatch)
 	}
@@ -204,6 +206,9 @@ func init() {
 	AwsxECSReadBytesCmd.PersistentFlags().String("externalId", "", "aws external id")
 	AwsxECSReadBytesCmd.PersistentFlags().String("cloudWatchQueries", "", "aws cloudwatch metric queries")
 	AwsxECSReadBytesCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxECSReadBytesCmd.PersistentFlags().String("ServiceName", "", "Service Name")
+	AwsxECSReadBytesCmd.PersistentFlags().String("TaskDefinitionFamily", "", "ECS task definition family")
+	AwsxECSReadBytesCmd.PersistentFlags().String("groupBy", "", "group series by. service")
 	AwsxECSReadBytesCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxECSReadBytesCmd.PersistentFlags().String("endTime", "", "endcl time")
 	AwsxECSReadBytesCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
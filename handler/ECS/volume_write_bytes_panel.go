@@ -113,8 +113,17 @@ func GetECSWriteBytesPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatc
 
 	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
 
+	groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+	if groupBy == "service" {
+		return GetECSMetricByService(cmd, clientAuth, instanceId, "ECS/ContainerInsights", "Sum", 60, []string{"StorageWriteBytes"}, startTime, endTime, cloudWatchClient)
+	}
+	dimensions, err := GetECSDimensions(cmd, instanceId, "ECS/ContainerInsights")
+	if err != nil {
+		return "", nil, err
+	}
+
 	// Fetch raw data
-	rawData, err := GetECSWriteBytesMetricData(clientAuth, instanceId, elementType, startTime, endTime, "Sum", cloudWatchClient)
+	rawData, err := GetECSWriteBytesMetricData(clientAuth, dimensions, elementType, startTime, endTime, "Sum", cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting raw data: ", err)
 		return "", nil, err
@@ -133,8 +142,8 @@ func GetECSWriteBytesPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatc
 }
 
 
-func GetECSWriteBytesMetricData(clientAuth *model.Auth, instanceID, elementType string, startTime, endTime *time.Time, statistic string, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
-	log.Printf("Getting metric data for instance %s in namespace %s from %v to %v", instanceID, elementType, startTime, endTime)
+func GetECSWriteBytesMetricData(clientAuth *model.Auth, dimensions []*cloudwatch.Dimension, elementType string, startTime, endTime *time.Time, statistic string, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+	log.Printf("Getting metric data for dimensions %v in namespace %s from %v to %v", dimensions, elementType, startTime, endTime)
 
 	elmType := "ECS/ContainerInsights"
 	if elementType == "ECS" {
@@ -148,12 +157,7 @@ func GetECSWriteBytesMetricData(clientAuth *model.Auth, instanceID, elementType
 				Id: aws.String("m1"),
 				MetricStat: &cloudwatch.MetricStat{
 					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ClusterName"),
-								Value: aws.String(instanceID),
-							},
-						},
+						Dimensions: dimensions,
 						MetricName: aws.String("StorageWriteBytes"),
 						Namespace:  aws.String(elmType),
 					},
@@ -204,6 +208,9 @@ func init() {
 	AwsxECSWriteBytesCmd.PersistentFlags().String("externalId", "", "aws external id")
 	AwsxECSWriteBytesCmd.PersistentFlags().String("cloudWatchQueries", "", "aws cloudwatch metric queries")
 	AwsxECSWriteBytesCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxECSWriteBytesCmd.PersistentFlags().String("ServiceName", "", "Service Name")
+	AwsxECSWriteBytesCmd.PersistentFlags().String("TaskDefinitionFamily", "", "ECS task definition family")
+	AwsxECSWriteBytesCmd.PersistentFlags().String("groupBy", "", "group series by. service")
 	AwsxECSWriteBytesCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxECSWriteBytesCmd.PersistentFlags().String("endTime", "", "endcl time")
 	AwsxECSWriteBytesCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
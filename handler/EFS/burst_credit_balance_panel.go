@@ -0,0 +1,156 @@
+package EFS
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/spf13/cobra"
+)
+
+// BurstCreditProjection projects the burst credit balance forward at the rate it changed
+// over the window.
+type BurstCreditProjection struct {
+	ThroughputMode string `json:"throughputMode"`
+	// DepletionTime is when the balance reaches zero. It is nil while the balance is not falling.
+	DepletionTime *time.Time `json:"depletionTime"`
+	Warning       string     `json:"warning,omitempty"`
+}
+
+type BurstCreditBalance struct {
+	BurstCreditBalance []Metric.DataPoint    `json:"BurstCreditBalance"`
+	Projection         BurstCreditProjection `json:"Projection"`
+}
+
+var burstCreditBalanceSeries = []Metric.Series{
+	{Label: "BurstCreditBalance", MetricName: "BurstCreditBalance", Statistic: "Average"},
+}
+
+var AwsxEFSBurstCreditBalanceCmd = &cobra.Command{
+	Use:   "efs_burst_credit_balance_panel",
+	Short: "get burst credit balance of the file system",
+	Long:  `command to get the burst credit balance of the file system and when it is projected to run out`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetEFSBurstCreditBalancePanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting efs burst credit balance: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetEFSBurstCreditBalancePanel returns the burst credit balance of the file system with a
+// projection of when it runs out. A file system in bursting mode whose credits run out
+// within another window of the same length gets a warning.
+func GetEFSBurstCreditBalancePanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	fileSystemId, err := GetEFSFileSystemId(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, time.Hour)
+	if err != nil {
+		return "", nil, err
+	}
+
+	fileSystem, err := DescribeFileSystem(clientAuth, fileSystemId)
+	if err != nil {
+		log.Println("Error in describing file system: ", err)
+		return "", nil, err
+	}
+
+	result, cloudwatchMetricData, err := Metric.GetMetricData(clientAuth, namespace, period, fileSystemDimensions(fileSystemId), burstCreditBalanceSeries, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting burst credit balance metric data: ", err)
+		return "", nil, err
+	}
+
+	balance := result["BurstCreditBalance"]
+	projection := projectBurstCredits(balance, aws.StringValue(fileSystem.ThroughputMode), endTime.Sub(*startTime))
+	if projection.Warning != "" {
+		log.Printf("Warning for file system %s: %s", fileSystemId, projection.Warning)
+	}
+
+	jsonString, err := json.Marshal(BurstCreditBalance{
+		BurstCreditBalance: balance,
+		Projection:         projection,
+	})
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+// projectBurstCredits extrapolates the balance linearly from its first and last point.
+func projectBurstCredits(balance []Metric.DataPoint, throughputMode string, window time.Duration) BurstCreditProjection {
+	projection := BurstCreditProjection{ThroughputMode: throughputMode}
+	if len(balance) < 2 {
+		return projection
+	}
+
+	first, last := balance[0], balance[len(balance)-1]
+	elapsed := last.Timestamp.Sub(first.Timestamp).Seconds()
+	if elapsed <= 0 || last.Value >= first.Value {
+		return projection
+	}
+
+	rate := (first.Value - last.Value) / elapsed
+	untilDepleted := time.Duration(last.Value / rate * float64(time.Second))
+	depletionTime := last.Timestamp.Add(untilDepleted)
+	projection.DepletionTime = &depletionTime
+
+	if throughputMode == efs.ThroughputModeBursting && untilDepleted <= window {
+		projection.Warning = fmt.Sprintf("burst credits are projected to run out at %s, within the %s window. throughput will drop to the baseline rate",
+			depletionTime.Format(time.RFC3339), window.Round(time.Second))
+	}
+	return projection
+}
+
+func init() {
+	AwsxEFSBurstCreditBalanceCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxEFSBurstCreditBalanceCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxEFSBurstCreditBalanceCmd.PersistentFlags().String("query", "", "query")
+	AwsxEFSBurstCreditBalanceCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxEFSBurstCreditBalanceCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxEFSBurstCreditBalanceCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxEFSBurstCreditBalanceCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxEFSBurstCreditBalanceCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxEFSBurstCreditBalanceCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxEFSBurstCreditBalanceCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxEFSBurstCreditBalanceCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxEFSBurstCreditBalanceCmd.PersistentFlags().String("FileSystemId", "", "efs file system id")
+	AwsxEFSBurstCreditBalanceCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxEFSBurstCreditBalanceCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxEFSBurstCreditBalanceCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxEFSBurstCreditBalanceCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
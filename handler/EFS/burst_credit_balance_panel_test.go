@@ -0,0 +1,80 @@
+package EFS
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/efs"
+)
+
+var windowStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func at(minutes int) time.Time {
+	return windowStart.Add(time.Duration(minutes) * time.Minute)
+}
+
+func TestProjectBurstCredits(t *testing.T) {
+	depletesAt50 := at(50)
+	depletesAt1000 := at(1000)
+
+	tests := []struct {
+		name           string
+		balance        []Metric.DataPoint
+		throughputMode string
+		wantDepletion  *time.Time
+		wantWarning    bool
+	}{
+		{
+			name:           "single point",
+			balance:        []Metric.DataPoint{{Timestamp: at(0), Value: 1000}},
+			throughputMode: efs.ThroughputModeBursting,
+		},
+		{
+			name:           "rising balance",
+			balance:        []Metric.DataPoint{{Timestamp: at(0), Value: 800}, {Timestamp: at(10), Value: 1000}},
+			throughputMode: efs.ThroughputModeBursting,
+		},
+		{
+			name:           "flat balance",
+			balance:        []Metric.DataPoint{{Timestamp: at(0), Value: 1000}, {Timestamp: at(10), Value: 1000}},
+			throughputMode: efs.ThroughputModeBursting,
+		},
+		{
+			name:           "bursting and depleted within the window",
+			balance:        []Metric.DataPoint{{Timestamp: at(0), Value: 1000}, {Timestamp: at(5), Value: 900}, {Timestamp: at(10), Value: 800}},
+			throughputMode: efs.ThroughputModeBursting,
+			wantDepletion:  &depletesAt50,
+			wantWarning:    true,
+		},
+		{
+			name:           "bursting and depleted after the window",
+			balance:        []Metric.DataPoint{{Timestamp: at(0), Value: 1000}, {Timestamp: at(10), Value: 990}},
+			throughputMode: efs.ThroughputModeBursting,
+			wantDepletion:  &depletesAt1000,
+		},
+		{
+			name:           "elastic throughput is not warned about",
+			balance:        []Metric.DataPoint{{Timestamp: at(0), Value: 1000}, {Timestamp: at(10), Value: 800}},
+			throughputMode: efs.ThroughputModeElastic,
+			wantDepletion:  &depletesAt50,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := projectBurstCredits(test.balance, test.throughputMode, time.Hour)
+			if got.ThroughputMode != test.throughputMode {
+				t.Errorf("ThroughputMode = %q, want %q", got.ThroughputMode, test.throughputMode)
+			}
+			switch {
+			case test.wantDepletion == nil && got.DepletionTime != nil:
+				t.Errorf("DepletionTime = %v, want nil", *got.DepletionTime)
+			case test.wantDepletion != nil && (got.DepletionTime == nil || !got.DepletionTime.Equal(*test.wantDepletion)):
+				t.Errorf("DepletionTime = %v, want %v", got.DepletionTime, *test.wantDepletion)
+			}
+			if (got.Warning != "") != test.wantWarning {
+				t.Errorf("Warning = %q, want a warning: %v", got.Warning, test.wantWarning)
+			}
+		})
+	}
+}
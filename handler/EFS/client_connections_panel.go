@@ -0,0 +1,73 @@
+package EFS
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var clientConnectionsSeries = []Metric.Series{
+	{Label: "ClientConnections", MetricName: "ClientConnections", Statistic: "Sum"},
+}
+
+var AwsxEFSClientConnectionsCmd = &cobra.Command{
+	Use:   "efs_client_connections_panel",
+	Short: "get client connections of the file system",
+	Long:  `command to get the number of client connections to the file system`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetEFSClientConnectionsPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting efs client connections: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetEFSClientConnectionsPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetEFSFileSystemPanel(cmd, clientAuth, clientConnectionsSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxEFSClientConnectionsCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxEFSClientConnectionsCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxEFSClientConnectionsCmd.PersistentFlags().String("query", "", "query")
+	AwsxEFSClientConnectionsCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxEFSClientConnectionsCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxEFSClientConnectionsCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxEFSClientConnectionsCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxEFSClientConnectionsCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxEFSClientConnectionsCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxEFSClientConnectionsCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxEFSClientConnectionsCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxEFSClientConnectionsCmd.PersistentFlags().String("FileSystemId", "", "efs file system id")
+	AwsxEFSClientConnectionsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxEFSClientConnectionsCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxEFSClientConnectionsCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxEFSClientConnectionsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
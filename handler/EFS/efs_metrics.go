@@ -0,0 +1,52 @@
+package EFS
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+const namespace = "AWS/EFS"
+
+const (
+	// period is the granularity of the EFS I/O and throughput metrics
+	period = 60
+	// storagePeriod is the granularity StorageBytes is published at
+	storagePeriod = 900
+)
+
+// GetEFSFileSystemPanel resolves the file system and returns the given series of it.
+func GetEFSFileSystemPanel(cmd *cobra.Command, clientAuth *model.Auth, series []Metric.Series, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	fileSystemId, err := GetEFSFileSystemId(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result, cloudwatchMetricData, err := Metric.GetMetricData(clientAuth, namespace, period, fileSystemDimensions(fileSystemId), series, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting file system metric data: ", err)
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func fileSystemDimensions(fileSystemId string) []*cloudwatch.Dimension {
+	return Metric.Dimensions("FileSystemId", fileSystemId)
+}
@@ -0,0 +1,65 @@
+package EFS
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/cmdb"
+	"github.com/Appkube-awsx/awsx-common/config"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/spf13/cobra"
+)
+
+// GetEFSFileSystemId returns the file system from the FileSystemId flag, the CMDB element
+// or the instanceId flag, in that order.
+func GetEFSFileSystemId(cmd *cobra.Command) (string, error) {
+	fileSystemId, _ := cmd.PersistentFlags().GetString("FileSystemId")
+	elementId, _ := cmd.PersistentFlags().GetString("elementId")
+	cmdbApiUrl, _ := cmd.PersistentFlags().GetString("cmdbApiUrl")
+	instanceId, _ := cmd.PersistentFlags().GetString("instanceId")
+
+	if fileSystemId != "" {
+		return fileSystemId, nil
+	}
+
+	if elementId != "" {
+		log.Println("getting cloud-element data from cmdb")
+		apiUrl := cmdbApiUrl
+		if cmdbApiUrl == "" {
+			log.Println("using default cmdb url")
+			apiUrl = config.CmdbUrl
+		}
+		log.Println("cmdb url: " + apiUrl)
+		cmdbData, err := cmdb.GetCloudElementData(apiUrl, elementId)
+		if err != nil {
+			return "", err
+		}
+		instanceId = cmdbData.InstanceId
+	}
+
+	if instanceId == "" {
+		return "", errors.New("file system id not provided. provide FileSystemId or elementId")
+	}
+	return instanceId, nil
+}
+
+// DescribeFileSystem returns the description of the file system.
+func DescribeFileSystem(clientAuth *model.Auth, fileSystemId string) (*efs.FileSystemDescription, error) {
+	// awsclient has no EFS client type, so the client is built on the assumed role session
+	efsClient := efs.New(awsclient.GetSessionWithAssumeRole(*clientAuth))
+
+	output, err := efsClient.DescribeFileSystems(&efs.DescribeFileSystemsInput{
+		FileSystemId: aws.String(fileSystemId),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(output.FileSystems) == 0 {
+		return nil, fmt.Errorf("file system %s not found", fileSystemId)
+	}
+	return output.FileSystems[0], nil
+}
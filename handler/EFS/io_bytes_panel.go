@@ -0,0 +1,75 @@
+package EFS
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var ioBytesSeries = []Metric.Series{
+	{Label: "DataReadIOBytes", MetricName: "DataReadIOBytes", Statistic: "Sum"},
+	{Label: "DataWriteIOBytes", MetricName: "DataWriteIOBytes", Statistic: "Sum"},
+	{Label: "MetadataIOBytes", MetricName: "MetadataIOBytes", Statistic: "Sum"},
+}
+
+var AwsxEFSIOBytesCmd = &cobra.Command{
+	Use:   "efs_io_bytes_panel",
+	Short: "get data and metadata io bytes of the file system",
+	Long:  `command to get the bytes of the data read, data write and metadata operations of the file system`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetEFSIOBytesPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting efs io bytes: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetEFSIOBytesPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetEFSFileSystemPanel(cmd, clientAuth, ioBytesSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxEFSIOBytesCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxEFSIOBytesCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxEFSIOBytesCmd.PersistentFlags().String("query", "", "query")
+	AwsxEFSIOBytesCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxEFSIOBytesCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxEFSIOBytesCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxEFSIOBytesCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxEFSIOBytesCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxEFSIOBytesCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxEFSIOBytesCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxEFSIOBytesCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxEFSIOBytesCmd.PersistentFlags().String("FileSystemId", "", "efs file system id")
+	AwsxEFSIOBytesCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxEFSIOBytesCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxEFSIOBytesCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxEFSIOBytesCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
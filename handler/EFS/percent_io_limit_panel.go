@@ -0,0 +1,73 @@
+package EFS
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var percentIOLimitSeries = []Metric.Series{
+	{Label: "PercentIOLimit", MetricName: "PercentIOLimit", Statistic: "Average"},
+}
+
+var AwsxEFSPercentIOLimitCmd = &cobra.Command{
+	Use:   "efs_percent_io_limit_panel",
+	Short: "get percent io limit of the file system",
+	Long:  `command to get how close a general purpose file system is to its io limit`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetEFSPercentIOLimitPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting efs percent io limit: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetEFSPercentIOLimitPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetEFSFileSystemPanel(cmd, clientAuth, percentIOLimitSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxEFSPercentIOLimitCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxEFSPercentIOLimitCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxEFSPercentIOLimitCmd.PersistentFlags().String("query", "", "query")
+	AwsxEFSPercentIOLimitCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxEFSPercentIOLimitCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxEFSPercentIOLimitCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxEFSPercentIOLimitCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxEFSPercentIOLimitCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxEFSPercentIOLimitCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxEFSPercentIOLimitCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxEFSPercentIOLimitCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxEFSPercentIOLimitCmd.PersistentFlags().String("FileSystemId", "", "efs file system id")
+	AwsxEFSPercentIOLimitCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxEFSPercentIOLimitCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxEFSPercentIOLimitCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxEFSPercentIOLimitCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,112 @@
+package EFS
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+// storageClasses are the StorageClass dimension values of StorageBytes
+var storageClasses = []string{"Total", "Standard", "IA", "Archive"}
+
+var AwsxEFSStorageBytesCmd = &cobra.Command{
+	Use:   "efs_storage_bytes_panel",
+	Short: "get storage bytes of the file system by storage class",
+	Long:  `command to get the size of the file system in total and per storage class`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetEFSStorageBytesPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting efs storage bytes: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetEFSStorageBytesPanel returns the size of the file system per storage class. StorageBytes
+// is published every 15 minutes, so without startTime the last day is returned.
+func GetEFSStorageBytesPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	fileSystemId, err := GetEFSFileSystemId(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 24*time.Hour)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var series []Metric.Series
+	for _, storageClass := range storageClasses {
+		series = append(series, Metric.Series{
+			Label:      storageClass,
+			MetricName: "StorageBytes",
+			Statistic:  "Average",
+			Dimensions: append(fileSystemDimensions(fileSystemId), &cloudwatch.Dimension{
+				Name:  aws.String("StorageClass"),
+				Value: aws.String(storageClass),
+			}),
+		})
+	}
+
+	result, cloudwatchMetricData, err := Metric.GetMetricData(clientAuth, namespace, storagePeriod, fileSystemDimensions(fileSystemId), series, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting storage bytes metric data: ", err)
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func init() {
+	AwsxEFSStorageBytesCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxEFSStorageBytesCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxEFSStorageBytesCmd.PersistentFlags().String("query", "", "query")
+	AwsxEFSStorageBytesCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxEFSStorageBytesCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxEFSStorageBytesCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxEFSStorageBytesCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxEFSStorageBytesCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxEFSStorageBytesCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxEFSStorageBytesCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxEFSStorageBytesCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxEFSStorageBytesCmd.PersistentFlags().String("FileSystemId", "", "efs file system id")
+	AwsxEFSStorageBytesCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxEFSStorageBytesCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxEFSStorageBytesCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxEFSStorageBytesCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
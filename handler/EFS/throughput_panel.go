@@ -0,0 +1,112 @@
+package EFS
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var throughputSeries = []Metric.Series{
+	{Label: "PermittedThroughput", MetricName: "PermittedThroughput", Statistic: "Average"},
+	{Label: "MeteredIOBytes", MetricName: "MeteredIOBytes", Statistic: "Sum"},
+}
+
+var AwsxEFSThroughputCmd = &cobra.Command{
+	Use:   "efs_throughput_panel",
+	Short: "get permitted and metered throughput of the file system",
+	Long:  `command to get the permitted throughput of the file system and the metered throughput used against it`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetEFSThroughputPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting efs throughput: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetEFSThroughputPanel returns the permitted throughput of the file system next to the
+// metered throughput, both in bytes per second. MeteredIOBytes is summed per period and
+// divided by it to get the rate.
+func GetEFSThroughputPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	fileSystemId, err := GetEFSFileSystemId(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result, cloudwatchMetricData, err := Metric.GetMetricData(clientAuth, namespace, period, fileSystemDimensions(fileSystemId), throughputSeries, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting throughput metric data: ", err)
+		return "", nil, err
+	}
+
+	meteredThroughput := []Metric.DataPoint{}
+	for _, point := range result["MeteredIOBytes"] {
+		meteredThroughput = append(meteredThroughput, Metric.DataPoint{
+			Timestamp: point.Timestamp,
+			Value:     point.Value / period,
+		})
+	}
+
+	jsonString, err := json.Marshal(map[string][]Metric.DataPoint{
+		"PermittedThroughput": result["PermittedThroughput"],
+		"MeteredThroughput":   meteredThroughput,
+	})
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func init() {
+	AwsxEFSThroughputCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxEFSThroughputCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxEFSThroughputCmd.PersistentFlags().String("query", "", "query")
+	AwsxEFSThroughputCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxEFSThroughputCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxEFSThroughputCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxEFSThroughputCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxEFSThroughputCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxEFSThroughputCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxEFSThroughputCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxEFSThroughputCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxEFSThroughputCmd.PersistentFlags().String("FileSystemId", "", "efs file system id")
+	AwsxEFSThroughputCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxEFSThroughputCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxEFSThroughputCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxEFSThroughputCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -112,8 +112,17 @@ func GetCPULimitsData(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClie
 
 	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
 
+	groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+	if groupBy != "" {
+		return GetEKSTopSeries(cmd, clientAuth, instanceId, "pod_cpu_limit", "Average", 60, startTime, endTime, cloudWatchClient)
+	}
+	dimensions, err := GetEKSPodDimensions(cmd, instanceId)
+	if err != nil {
+		return "", nil, err
+	}
+
 	// Fetch raw data
-	rawData, err := GetCPULimitsMetricData(clientAuth, instanceId, elementType, startTime, endTime, cloudWatchClient)
+	rawData, err := GetCPULimitsMetricData(clientAuth, dimensions, elementType, startTime, endTime, cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting raw data: ", err)
 		return "", nil, err
@@ -135,8 +144,8 @@ func GetCPULimitsData(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClie
 	return string(jsonString), cloudwatchMetricData, nil
 }
 
-func GetCPULimitsMetricData(clientAuth *model.Auth, instanceId, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
-	log.Printf("Getting metric data for instance %s in namespace %s from %v to %v", instanceId, elementType, startTime, endTime)
+func GetCPULimitsMetricData(clientAuth *model.Auth, dimensions []*cloudwatch.Dimension, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+	log.Printf("Getting metric data for dimensions %v in namespace %s from %v to %v", dimensions, elementType, startTime, endTime)
 	elmType := "ContainerInsights"
 	input := &cloudwatch.GetMetricDataInput{
 		EndTime:   endTime,
@@ -146,12 +155,7 @@ func GetCPULimitsMetricData(clientAuth *model.Auth, instanceId, elementType stri
 				Id: aws.String("m1"),
 				MetricStat: &cloudwatch.MetricStat{
 					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ClusterName"),
-								Value: aws.String(instanceId),
-							},
-						},
+						Dimensions: dimensions,
 						MetricName: aws.String("pod_cpu_limit"),
 						Namespace:  aws.String(elmType),
 					},
@@ -201,6 +205,11 @@ func init() {
 	AwsxEKSCpuLimitsCmd.PersistentFlags().String("externalId", "", "aws external id")
 	AwsxEKSCpuLimitsCmd.PersistentFlags().String("cloudWatchQueries", "", "aws cloudwatch metric queries")
 	AwsxEKSCpuLimitsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxEKSCpuLimitsCmd.PersistentFlags().String("Namespace", "", "kubernetes namespace")
+	AwsxEKSCpuLimitsCmd.PersistentFlags().String("PodName", "", "pod name")
+	AwsxEKSCpuLimitsCmd.PersistentFlags().String("ServiceName", "", "Service Name")
+	AwsxEKSCpuLimitsCmd.PersistentFlags().String("groupBy", "", "group series by. namespace/pod")
+	AwsxEKSCpuLimitsCmd.PersistentFlags().String("topN", "", "number of series returned with groupBy. defaults to 10")
 	AwsxEKSCpuLimitsCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxEKSCpuLimitsCmd.PersistentFlags().String("endTime", "", "endcl time")
 	AwsxEKSCpuLimitsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
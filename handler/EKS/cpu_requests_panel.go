@@ -112,8 +112,17 @@ func GetCPURequestData(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchCli
 
 	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
 
+	groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+	if groupBy != "" {
+		return GetEKSTopSeries(cmd, clientAuth, instanceId, "pod_cpu_request", "Average", 60, startTime, endTime, cloudWatchClient)
+	}
+	dimensions, err := GetEKSPodDimensions(cmd, instanceId)
+	if err != nil {
+		return "", nil, err
+	}
+
 	// Fetch raw data
-	rawData, err := GetCPURequestMetricData(clientAuth, instanceId, elementType, startTime, endTime, cloudWatchClient)
+	rawData, err := GetCPURequestMetricData(clientAuth, dimensions, elementType, startTime, endTime, cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting raw data: ", err)
 		return "", nil, err
@@ -135,7 +144,7 @@ func GetCPURequestData(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchCli
 	return string(jsonString), cloudwatchMetricData, nil
 }
 
-func GetCPURequestMetricData(clientAuth *model.Auth, instanceId, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+func GetCPURequestMetricData(clientAuth *model.Auth, dimensions []*cloudwatch.Dimension, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
 	elmType := "ContainerInsights"
 	input := &cloudwatch.GetMetricDataInput{
 		EndTime:   endTime,
@@ -145,12 +154,7 @@ func GetCPURequestMetricData(clientAuth *model.Auth, instanceId, elementType str
 				Id: aws.String("m1"),
 				MetricStat: &cloudwatch.MetricStat{
 					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ClusterName"),
-								Value: aws.String(instanceId),
-							},
-						},
+						Dimensions: dimensions,
 						MetricName: aws.String("pod_cpu_request"),
 						Namespace:  aws.String(elmType),
 					},
@@ -200,6 +204,11 @@ func init() {
 	AwsxEKSCpuRequestsCmd.PersistentFlags().String("externalId", "", "aws external id")
 	AwsxEKSCpuRequestsCmd.PersistentFlags().String("cloudWatchQueries", "", "aws cloudwatch metric queries")
 	AwsxEKSCpuRequestsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxEKSCpuRequestsCmd.PersistentFlags().String("Namespace", "", "kubernetes namespace")
+	AwsxEKSCpuRequestsCmd.PersistentFlags().String("PodName", "", "pod name")
+	AwsxEKSCpuRequestsCmd.PersistentFlags().String("ServiceName", "", "Service Name")
+	AwsxEKSCpuRequestsCmd.PersistentFlags().String("groupBy", "", "group series by. namespace/pod/node")
+	AwsxEKSCpuRequestsCmd.PersistentFlags().String("topN", "", "number of series returned with groupBy. defaults to 10")
 	AwsxEKSCpuRequestsCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxEKSCpuRequestsCmd.PersistentFlags().String("endTime", "", "endcl time")
 	AwsxEKSCpuRequestsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
@@ -112,8 +112,17 @@ func GetCPUUtilizationData(cmd *cobra.Command, clientAuth *model.Auth, cloudWatc
 
 	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
 
+	groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+	if groupBy != "" {
+		return GetEKSTopSeries(cmd, clientAuth, instanceId, "pod_cpu_utilization", "Average", 60, startTime, endTime, cloudWatchClient)
+	}
+	dimensions, err := GetEKSPodDimensions(cmd, instanceId)
+	if err != nil {
+		return "", nil, err
+	}
+
 	// Fetch raw data
-	rawData, err := GetCPUUtilizationMetricData(clientAuth, instanceId, elementType, startTime, endTime, cloudWatchClient)
+	rawData, err := GetCPUUtilizationMetricData(clientAuth, dimensions, elementType, startTime, endTime, cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting raw data: ", err)
 		return "", nil, err
@@ -135,7 +144,7 @@ func GetCPUUtilizationData(cmd *cobra.Command, clientAuth *model.Auth, cloudWatc
 	return string(jsonString), cloudwatchMetricData, nil
 }
 
-func GetCPUUtilizationMetricData(clientAuth *model.Auth, instanceId, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+func GetCPUUtilizationMetricData(clientAuth *model.Auth, dimensions []*cloudwatch.Dimension, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
 
 	elmType := "ContainerInsights"
 	input := &cloudwatch.GetMetricDataInput{
@@ -146,12 +155,7 @@ func GetCPUUtilizationMetricData(clientAuth *model.Auth, instanceId, elementType
 				Id: aws.String("m1"),
 				MetricStat: &cloudwatch.MetricStat{
 					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ClusterName"),
-								Value: aws.String(instanceId),
-							},
-						},
+						Dimensions: dimensions,
 						MetricName: aws.String("pod_cpu_utilization"),
 						Namespace:  aws.String(elmType),
 					},
@@ -201,6 +205,11 @@ func init() {
 	AwsxEKSCpuUtilizationGraphCmd.PersistentFlags().String("externalId", "", "aws external id")
 	AwsxEKSCpuUtilizationGraphCmd.PersistentFlags().String("cloudWatchQueries", "", "aws cloudwatch metric queries")
 	AwsxEKSCpuUtilizationGraphCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxEKSCpuUtilizationGraphCmd.PersistentFlags().String("Namespace", "", "kubernetes namespace")
+	AwsxEKSCpuUtilizationGraphCmd.PersistentFlags().String("PodName", "", "pod name")
+	AwsxEKSCpuUtilizationGraphCmd.PersistentFlags().String("ServiceName", "", "Service Name")
+	AwsxEKSCpuUtilizationGraphCmd.PersistentFlags().String("groupBy", "", "group series by. namespace/pod/node")
+	AwsxEKSCpuUtilizationGraphCmd.PersistentFlags().String("topN", "", "number of series returned with groupBy. defaults to 10")
 	AwsxEKSCpuUtilizationGraphCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxEKSCpuUtilizationGraphCmd.PersistentFlags().String("endTime", "", "endcl time")
 	AwsxEKSCpuUtilizationGraphCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
@@ -0,0 +1,78 @@
+package EKS
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Alarm"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var AwsxEKSMeanTimeToRecoveryCmd = &cobra.Command{
+	Use:   "mean_time_to_recovery_panel",
+	Short: "get mean time to recovery data",
+	Long:  `command to get mean time to recovery data`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, recoveryStats, err := GetEKSMeanTimeToRecoveryPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting mean time to recovery: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(recoveryStats)
+			} else {
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetEKSMeanTimeToRecoveryPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, *Alarm.RecoveryStats, error) {
+	// A failed node marks the cluster as degraded. node_status_condition_ready is only
+	// published per node, so the cluster level failed node count is used instead
+	return Alarm.GetMeanTimeToRecoveryPanel(cmd, clientAuth, "EKS", func(clusterName string) *Alarm.HealthMetric {
+		return &Alarm.HealthMetric{
+			Namespace:  "ContainerInsights",
+			MetricName: "cluster_failed_node_count",
+			Dimensions: map[string]string{"ClusterName": clusterName},
+			Stat:       "Maximum",
+			Period:     60,
+			Failing:    func(value float64) bool { return value > 0 },
+		}
+	}, cloudWatchClient)
+}
+
+func init() {
+	AwsxEKSMeanTimeToRecoveryCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxEKSMeanTimeToRecoveryCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxEKSMeanTimeToRecoveryCmd.PersistentFlags().String("query", "", "query")
+	AwsxEKSMeanTimeToRecoveryCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxEKSMeanTimeToRecoveryCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxEKSMeanTimeToRecoveryCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxEKSMeanTimeToRecoveryCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxEKSMeanTimeToRecoveryCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxEKSMeanTimeToRecoveryCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxEKSMeanTimeToRecoveryCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxEKSMeanTimeToRecoveryCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxEKSMeanTimeToRecoveryCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxEKSMeanTimeToRecoveryCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxEKSMeanTimeToRecoveryCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxEKSMeanTimeToRecoveryCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
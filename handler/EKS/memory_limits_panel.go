@@ -112,8 +112,17 @@ func GetMemoryLimitsData(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchC
 
 	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
 
+	groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+	if groupBy != "" {
+		return GetEKSTopSeries(cmd, clientAuth, instanceId, "pod_memory_limit", "Average", 60, startTime, endTime, cloudWatchClient)
+	}
+	dimensions, err := GetEKSPodDimensions(cmd, instanceId)
+	if err != nil {
+		return "", nil, err
+	}
+
 	// Fetch raw data
-	rawData, err := GetMemoryLimitsMetricData(clientAuth, instanceId, elementType, startTime, endTime, cloudWatchClient)
+	rawData, err := GetMemoryLimitsMetricData(clientAuth, dimensions, elementType, startTime, endTime, cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting raw data: ", err)
 		return "", nil, err
@@ -131,7 +140,7 @@ func GetMemoryLimitsData(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchC
 	return string(jsonString), cloudwatchMetricData, nil
 }
 
-func GetMemoryLimitsMetricData(clientAuth *model.Auth, instanceId, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+func GetMemoryLimitsMetricData(clientAuth *model.Auth, dimensions []*cloudwatch.Dimension, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
 	elmType := "ContainerInsights"
 	input := &cloudwatch.GetMetricDataInput{
 		EndTime:   endTime,
@@ -141,12 +150,7 @@ func GetMemoryLimitsMetricData(clientAuth *model.Auth, instanceId, elementType s
 				Id: aws.String("m1"),
 				MetricStat: &cloudwatch.MetricStat{
 					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ClusterName"),
-								Value: aws.String(instanceId),
-							},
-						},
+						Dimensions: dimensions,
 						MetricName: aws.String("pod_memory_limit"),
 						Namespace:  aws.String(elmType),
 					},
@@ -196,6 +200,11 @@ func init() {
 	AwsxEKSMemoryLimitsCmd.PersistentFlags().String("externalId", "", "aws external id")
 	AwsxEKSMemoryLimitsCmd.PersistentFlags().String("cloudWatchQueries", "", "aws cloudwatch metric queries")
 	AwsxEKSMemoryLimitsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxEKSMemoryLimitsCmd.PersistentFlags().String("Namespace", "", "kubernetes namespace")
+	AwsxEKSMemoryLimitsCmd.PersistentFlags().String("PodName", "", "pod name")
+	AwsxEKSMemoryLimitsCmd.PersistentFlags().String("ServiceName", "", "Service Name")
+	AwsxEKSMemoryLimitsCmd.PersistentFlags().String("groupBy", "", "group series by. namespace/pod")
+	AwsxEKSMemoryLimitsCmd.PersistentFlags().String("topN", "", "number of series returned with groupBy. defaults to 10")
 	AwsxEKSMemoryLimitsCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxEKSMemoryLimitsCmd.PersistentFlags().String("endTime", "", "endcl time")
 	AwsxEKSMemoryLimitsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
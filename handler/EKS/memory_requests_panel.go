@@ -112,8 +112,17 @@ func GetMemoryRequestData(cmd *cobra.Command, clientAuth *model.Auth, cloudWatch
 
 	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
 
+	groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+	if groupBy != "" {
+		return GetEKSTopSeries(cmd, clientAuth, instanceId, "pod_memory_request", "Average", 60, startTime, endTime, cloudWatchClient)
+	}
+	dimensions, err := GetEKSPodDimensions(cmd, instanceId)
+	if err != nil {
+		return "", nil, err
+	}
+
 	// Fetch raw data
-	rawData, err := GetMemoryRequestMetricData(clientAuth, instanceId, elementType, startTime, endTime, cloudWatchClient)
+	rawData, err := GetMemoryRequestMetricData(clientAuth, dimensions, elementType, startTime, endTime, cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting raw data: ", err)
 		return "", nil, err
@@ -135,7 +144,7 @@ func GetMemoryRequestData(cmd *cobra.Command, clientAuth *model.Auth, cloudWatch
 	return string(jsonString), cloudwatchMetricData, nil
 }
 
-func GetMemoryRequestMetricData(clientAuth *model.Auth, instanceId, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+func GetMemoryRequestMetricData(clientAuth *model.Auth, dimensions []*cloudwatch.Dimension, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
 	elmType := "ContainerInsights"
 	input := &cloudwatch.GetMetricDataInput{
 		EndTime:   endTime,
@@ -145,12 +154,7 @@ func GetMemoryRequestMetricData(clientAuth *model.Auth, instanceId, elementType
 				Id: aws.String("m1"),
 				MetricStat: &cloudwatch.MetricStat{
 					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ClusterName"),
-								Value: aws.String(instanceId),
-							},
-						},
+						Dimensions: dimensions,
 						MetricName: aws.String("pod_memory_request"),
 						Namespace:  aws.String(elmType),
 					},
@@ -200,6 +204,11 @@ func init() {
 	AwsxEKSMemoryRequestsCmd.PersistentFlags().String("externalId", "", "aws external id")
 	AwsxEKSMemoryRequestsCmd.PersistentFlags().String("cloudWatchQueries", "", "aws cloudwatch metric queries")
 	AwsxEKSMemoryRequestsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxEKSMemoryRequestsCmd.PersistentFlags().String("Namespace", "", "kubernetes namespace")
+	AwsxEKSMemoryRequestsCmd.PersistentFlags().String("PodName", "", "pod name")
+	AwsxEKSMemoryRequestsCmd.PersistentFlags().String("ServiceName", "", "Service Name")
+	AwsxEKSMemoryRequestsCmd.PersistentFlags().String("groupBy", "", "group series by. namespace/pod/node")
+	AwsxEKSMemoryRequestsCmd.PersistentFlags().String("topN", "", "number of series returned with groupBy. defaults to 10")
 	AwsxEKSMemoryRequestsCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxEKSMemoryRequestsCmd.PersistentFlags().String("endTime", "", "endcl time")
 	AwsxEKSMemoryRequestsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
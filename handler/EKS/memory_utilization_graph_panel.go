@@ -110,7 +110,16 @@ func GetMemoryUtilizationGraphData(cmd *cobra.Command, clientAuth *model.Auth, c
 
 	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
 
-	rawData, err := GetMemoryUtilizationGraphMetricData(clientAuth, instanceId, elementType, startTime, endTime, cloudWatchClient)
+	groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+	if groupBy != "" {
+		return GetEKSTopSeries(cmd, clientAuth, instanceId, "pod_memory_utilization", "Average", 60, startTime, endTime, cloudWatchClient)
+	}
+	dimensions, err := GetEKSPodDimensions(cmd, instanceId)
+	if err != nil {
+		return "", nil, err
+	}
+
+	rawData, err := GetMemoryUtilizationGraphMetricData(clientAuth, dimensions, elementType, startTime, endTime, cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting raw data: ", err)
 		return "", nil, err
@@ -128,7 +137,7 @@ func GetMemoryUtilizationGraphData(cmd *cobra.Command, clientAuth *model.Auth, c
 	return string(jsonString), cloudwatchMetricData, nil
 }
 
-func GetMemoryUtilizationGraphMetricData(clientAuth *model.Auth, instanceId, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+func GetMemoryUtilizationGraphMetricData(clientAuth *model.Auth, dimensions []*cloudwatch.Dimension, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
 	elmType := "ContainerInsights"
 	input := &cloudwatch.GetMetricDataInput{
 		EndTime:   endTime,
@@ -138,12 +147,7 @@ func GetMemoryUtilizationGraphMetricData(clientAuth *model.Auth, instanceId, ele
 				Id: aws.String("m1"),
 				MetricStat: &cloudwatch.MetricStat{
 					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("ClusterName"),
-								Value: aws.String(instanceId),
-							},
-						},
+						Dimensions: dimensions,
 						MetricName: aws.String("pod_memory_utilization"),
 						Namespace:  aws.String(elmType),
 					},
@@ -193,6 +197,11 @@ func init() {
 	AwsxEKSMemoryUtilizationGraphCmd.PersistentFlags().String("externalId", "", "aws external id")
 	AwsxEKSMemoryUtilizationGraphCmd.PersistentFlags().String("cloudWatchQueries", "", "aws cloudwatch metric queries")
 	AwsxEKSMemoryUtilizationGraphCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxEKSMemoryUtilizationGraphCmd.PersistentFlags().String("Namespace", "", "kubernetes namespace")
+	AwsxEKSMemoryUtilizationGraphCmd.PersistentFlags().String("PodName", "", "pod name")
+	AwsxEKSMemoryUtilizationGraphCmd.PersistentFlags().String("ServiceName", "", "Service Name")
+	AwsxEKSMemoryUtilizationGraphCmd.PersistentFlags().String("groupBy", "", "group series by. namespace/pod/node")
+	AwsxEKSMemoryUtilizationGraphCmd.PersistentFlags().String("topN", "", "number of series returned with groupBy. defaults to 10")
 	AwsxEKSMemoryUtilizationGraphCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxEKSMemoryUtilizationGraphCmd.PersistentFlags().String("endTime", "", "endcl time")
 	AwsxEKSMemoryUtilizationGraphCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
@@ -0,0 +1,233 @@
+package EKS
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+const defaultTopN = 10
+
+// groupByDimensions lists the Container Insights dimension set each groupBy mode
+// reads, with the dimension naming a series last
+var groupByDimensions = map[string][]string{
+	"namespace": {"ClusterName", "Namespace"},
+	"pod":       {"ClusterName", "Namespace", "PodName"},
+	"node":      {"ClusterName", "InstanceId", "NodeName"},
+}
+
+// nodeMetrics maps pod metrics to the node metric used for groupBy=node, since pod
+// metrics carry no node dimension. Pod limits have no node counterpart: node_cpu_limit
+// and node_memory_limit are the node capacity, so the limit panels reject groupBy=node.
+var nodeMetrics = map[string]string{
+	"pod_cpu_request":        "node_cpu_reserved_capacity",
+	"pod_memory_request":     "node_memory_reserved_capacity",
+	"pod_cpu_utilization":    "node_cpu_utilization",
+	"pod_memory_utilization": "node_memory_utilization",
+}
+
+type WorkloadSeries struct {
+	Group   string             `json:"group"`
+	Average float64            `json:"average"`
+	RawData []Metric.DataPoint `json:"RawData"`
+}
+
+// GetEKSPodDimensions returns the dimensions for pod metrics in the cluster, narrowed
+// by the Namespace, PodName and ServiceName flags. Container Insights publishes pod
+// metrics per Namespace, per Namespace+PodName and per Namespace+Service.
+func GetEKSPodDimensions(cmd *cobra.Command, clusterName string) ([]*cloudwatch.Dimension, error) {
+	namespace, _ := cmd.PersistentFlags().GetString("Namespace")
+	podName, _ := cmd.PersistentFlags().GetString("PodName")
+	serviceName, _ := cmd.PersistentFlags().GetString("ServiceName")
+
+	if podName != "" && serviceName != "" {
+		return nil, errors.New("PodName and ServiceName cannot be combined")
+	}
+	if (podName != "" || serviceName != "") && namespace == "" {
+		return nil, errors.New("Namespace is required with PodName or ServiceName")
+	}
+
+	dimensions := []*cloudwatch.Dimension{
+		{
+			Name:  aws.String("ClusterName"),
+			Value: aws.String(clusterName),
+		},
+	}
+	if namespace != "" {
+		dimensions = append(dimensions, &cloudwatch.Dimension{
+			Name:  aws.String("Namespace"),
+			Value: aws.String(namespace),
+		})
+	}
+	if podName != "" {
+		dimensions = append(dimensions, &cloudwatch.Dimension{
+			Name:  aws.String("PodName"),
+			Value: aws.String(podName),
+		})
+	}
+	if serviceName != "" {
+		dimensions = append(dimensions, &cloudwatch.Dimension{
+			Name:  aws.String("Service"),
+			Value: aws.String(serviceName),
+		})
+	}
+	return dimensions, nil
+}
+
+// GetEKSTopSeries returns the topN series of a pod metric grouped by namespace, pod
+// or node, ordered by their average over the window. The Namespace flag narrows
+// groupBy=pod to one namespace.
+func GetEKSTopSeries(cmd *cobra.Command, clientAuth *model.Auth, clusterName, metricName, statistic string, period int64, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+	namespace, _ := cmd.PersistentFlags().GetString("Namespace")
+	topNStr, _ := cmd.PersistentFlags().GetString("topN")
+
+	dimensionNames, ok := groupByDimensions[groupBy]
+	if !ok {
+		return "", nil, fmt.Errorf("unsupported groupBy %q. use namespace, pod or node", groupBy)
+	}
+	metricName, err := groupMetricName(metricName, groupBy)
+	if err != nil {
+		return "", nil, err
+	}
+
+	topN := defaultTopN
+	if topNStr != "" {
+		parsedTopN, err := strconv.Atoi(topNStr)
+		if err != nil || parsedTopN < 1 {
+			return "", nil, fmt.Errorf("invalid topN %q", topNStr)
+		}
+		topN = parsedTopN
+	}
+
+	if cloudWatchClient == nil {
+		cloudWatchClient = awsclient.GetClient(*clientAuth, awsclient.CLOUDWATCH).(*cloudwatch.CloudWatch)
+	}
+
+	filters := []*cloudwatch.DimensionFilter{
+		{
+			Name:  aws.String("ClusterName"),
+			Value: aws.String(clusterName),
+		},
+	}
+	if namespace != "" && groupBy == "pod" {
+		filters = append(filters, &cloudwatch.DimensionFilter{
+			Name:  aws.String("Namespace"),
+			Value: aws.String(namespace),
+		})
+	}
+	metrics, err := Metric.ListMetrics(cloudWatchClient, "ContainerInsights", metricName, filters)
+	if err != nil {
+		log.Println("Error listing metrics: ", err)
+		return "", nil, err
+	}
+
+	// Only the metrics published with exactly the groupBy dimension set are read, as
+	// each of them is one group
+	groupDimensions := map[string][]*cloudwatch.Dimension{}
+	for _, metric := range metrics {
+		if hasDimensionSet(metric.Dimensions, dimensionNames) {
+			groupDimensions[groupLabel(metric.Dimensions, groupBy)] = metric.Dimensions
+		}
+	}
+
+	series := []Metric.Series{{Label: metricName, MetricName: metricName, Statistic: statistic}}
+	result, cloudwatchMetricData, err := Metric.GetEntityMetricData(clientAuth, "ContainerInsights", period, groupDimensions, series, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting grouped metric data: ", err)
+		return "", nil, err
+	}
+
+	groupSeries := map[string][]Metric.DataPoint{}
+	for group, groupResult := range result {
+		groupSeries[group] = groupResult[metricName]
+	}
+	topSeries := topWorkloadSeries(groupSeries, topN)
+	topMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
+	for _, s := range topSeries {
+		topMetricData[s.Group] = cloudwatchMetricData[s.Group]
+	}
+
+	jsonString, err := json.Marshal(topSeries)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+	return string(jsonString), topMetricData, nil
+}
+
+// groupMetricName returns the metric read for groupBy, which is the node counterpart of
+// the pod metric for groupBy=node.
+func groupMetricName(metricName, groupBy string) (string, error) {
+	if groupBy != "node" {
+		return metricName, nil
+	}
+	nodeMetric, ok := nodeMetrics[metricName]
+	if !ok {
+		return "", fmt.Errorf("groupBy node is not supported for %s", metricName)
+	}
+	return nodeMetric, nil
+}
+
+// topWorkloadSeries returns the topN groups by average, highest first. Groups with the
+// same average are ordered by name.
+func topWorkloadSeries(groupSeries map[string][]Metric.DataPoint, topN int) []*WorkloadSeries {
+	topSeries := []*WorkloadSeries{}
+	for group, points := range groupSeries {
+		series := &WorkloadSeries{Group: group, RawData: points}
+		for _, point := range points {
+			series.Average += point.Value
+		}
+		if len(points) > 0 {
+			series.Average /= float64(len(points))
+		}
+		topSeries = append(topSeries, series)
+	}
+	sort.Slice(topSeries, func(i, j int) bool {
+		if topSeries[i].Average != topSeries[j].Average {
+			return topSeries[i].Average > topSeries[j].Average
+		}
+		return topSeries[i].Group < topSeries[j].Group
+	})
+	if len(topSeries) > topN {
+		topSeries = topSeries[:topN]
+	}
+	return topSeries
+}
+
+func hasDimensionSet(dimensions []*cloudwatch.Dimension, names []string) bool {
+	if len(dimensions) != len(names) {
+		return false
+	}
+	for _, name := range names {
+		if Metric.DimensionValue(dimensions, name) == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// groupLabel names a series. Pods are qualified by namespace since pod names are
+// only unique within one.
+func groupLabel(dimensions []*cloudwatch.Dimension, groupBy string) string {
+	switch groupBy {
+	case "pod":
+		return strings.Join([]string{Metric.DimensionValue(dimensions, "Namespace"), Metric.DimensionValue(dimensions, "PodName")}, "/")
+	case "node":
+		return Metric.DimensionValue(dimensions, "NodeName")
+	default:
+		return Metric.DimensionValue(dimensions, "Namespace")
+	}
+}
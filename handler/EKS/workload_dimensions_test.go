@@ -0,0 +1,147 @@
+package EKS
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+func dimensions(nameValues ...string) []*cloudwatch.Dimension {
+	var dimensions []*cloudwatch.Dimension
+	for i := 0; i < len(nameValues); i += 2 {
+		dimensions = append(dimensions, &cloudwatch.Dimension{
+			Name:  aws.String(nameValues[i]),
+			Value: aws.String(nameValues[i+1]),
+		})
+	}
+	return dimensions
+}
+
+func TestHasDimensionSet(t *testing.T) {
+	tests := []struct {
+		name       string
+		dimensions []*cloudwatch.Dimension
+		groupBy    string
+		want       bool
+	}{
+		{"namespace", dimensions("ClusterName", "c", "Namespace", "default"), "namespace", true},
+		{"pod", dimensions("ClusterName", "c", "Namespace", "default", "PodName", "web"), "pod", true},
+		{"node", dimensions("ClusterName", "c", "InstanceId", "i-1", "NodeName", "ip-10-0-0-1"), "node", true},
+		{"extra dimension", dimensions("ClusterName", "c", "Namespace", "default", "PodName", "web"), "namespace", false},
+		{"missing dimension", dimensions("ClusterName", "c", "Namespace", "default"), "pod", false},
+		{"other dimension set", dimensions("ClusterName", "c", "Namespace", "default", "Service", "web"), "pod", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := hasDimensionSet(test.dimensions, groupByDimensions[test.groupBy]); got != test.want {
+				t.Errorf("hasDimensionSet = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestGroupLabel(t *testing.T) {
+	tests := []struct {
+		groupBy    string
+		dimensions []*cloudwatch.Dimension
+		want       string
+	}{
+		{"namespace", dimensions("ClusterName", "c", "Namespace", "default"), "default"},
+		{"pod", dimensions("ClusterName", "c", "Namespace", "default", "PodName", "web"), "default/web"},
+		{"node", dimensions("ClusterName", "c", "InstanceId", "i-1", "NodeName", "ip-10-0-0-1"), "ip-10-0-0-1"},
+	}
+	for _, test := range tests {
+		t.Run(test.groupBy, func(t *testing.T) {
+			if got := groupLabel(test.dimensions, test.groupBy); got != test.want {
+				t.Errorf("groupLabel = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestTopWorkloadSeries(t *testing.T) {
+	now := time.Now()
+	points := func(values ...float64) []Metric.DataPoint {
+		var points []Metric.DataPoint
+		for i, value := range values {
+			points = append(points, Metric.DataPoint{Timestamp: now.Add(time.Duration(i) * time.Minute), Value: value})
+		}
+		return points
+	}
+	groupSeries := map[string][]Metric.DataPoint{
+		"low":     points(1, 3),
+		"high":    points(10, 20),
+		"tied-b":  points(5),
+		"tied-a":  points(4, 6),
+		"no-data": points(),
+	}
+
+	tests := []struct {
+		name   string
+		topN   int
+		groups []string
+	}{
+		{"all", 10, []string{"high", "tied-a", "tied-b", "low", "no-data"}},
+		{"truncated", 2, []string{"high", "tied-a"}},
+		{"one", 1, []string{"high"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			topSeries := topWorkloadSeries(groupSeries, test.topN)
+			var groups []string
+			for _, series := range topSeries {
+				groups = append(groups, series.Group)
+			}
+			if !reflect.DeepEqual(groups, test.groups) {
+				t.Errorf("groups = %v, want %v", groups, test.groups)
+			}
+		})
+	}
+
+	topSeries := topWorkloadSeries(groupSeries, 1)
+	if topSeries[0].Average != 15 {
+		t.Errorf("average = %v, want 15", topSeries[0].Average)
+	}
+	if len(topWorkloadSeries(map[string][]Metric.DataPoint{}, 10)) != 0 {
+		t.Error("expected no series without groups")
+	}
+}
+
+func TestGroupMetricName(t *testing.T) {
+	tests := []struct {
+		metricName string
+		groupBy    string
+		want       string
+		wantErr    bool
+	}{
+		{"pod_cpu_limit", "namespace", "pod_cpu_limit", false},
+		{"pod_memory_limit", "pod", "pod_memory_limit", false},
+		{"pod_cpu_limit", "node", "", true},
+		{"pod_memory_limit", "node", "", true},
+		{"pod_cpu_request", "node", "node_cpu_reserved_capacity", false},
+		{"pod_memory_request", "node", "node_memory_reserved_capacity", false},
+		{"pod_cpu_utilization", "node", "node_cpu_utilization", false},
+		{"pod_memory_utilization", "node", "node_memory_utilization", false},
+	}
+	for _, test := range tests {
+		t.Run(test.metricName+"/"+test.groupBy, func(t *testing.T) {
+			got, err := groupMetricName(test.metricName, test.groupBy)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.want {
+				t.Errorf("groupMetricName = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
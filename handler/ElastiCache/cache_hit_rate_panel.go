@@ -0,0 +1,75 @@
+package ElastiCache
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var cacheHitRateSeries = []Metric.Series{
+	{Label: "CacheHitRate", MetricName: "CacheHitRate", Statistic: "Average"},
+	{Label: "CacheHits", MetricName: "CacheHits", Statistic: "Sum"},
+	{Label: "CacheMisses", MetricName: "CacheMisses", Statistic: "Sum"},
+}
+
+var AwsxElastiCacheCacheHitRateCmd = &cobra.Command{
+	Use:   "elasticache_cache_hit_rate_panel",
+	Short: "get cache hit rate of the cache nodes",
+	Long:  `command to get the cache hit rate, hits and misses of every node of the cluster`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetElastiCacheCacheHitRatePanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting elasticache cache hit rate: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetElastiCacheCacheHitRatePanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetElastiCacheNodePanel(cmd, clientAuth, cacheHitRateSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxElastiCacheCacheHitRateCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxElastiCacheCacheHitRateCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxElastiCacheCacheHitRateCmd.PersistentFlags().String("query", "", "query")
+	AwsxElastiCacheCacheHitRateCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxElastiCacheCacheHitRateCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxElastiCacheCacheHitRateCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxElastiCacheCacheHitRateCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxElastiCacheCacheHitRateCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxElastiCacheCacheHitRateCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxElastiCacheCacheHitRateCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxElastiCacheCacheHitRateCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxElastiCacheCacheHitRateCmd.PersistentFlags().String("ReplicationGroupId", "", "elasticache replication group or cache cluster id")
+	AwsxElastiCacheCacheHitRateCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxElastiCacheCacheHitRateCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxElastiCacheCacheHitRateCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxElastiCacheCacheHitRateCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,127 @@
+package ElastiCache
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/cmdb"
+	"github.com/Appkube-awsx/awsx-common/config"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/spf13/cobra"
+)
+
+// CacheNode is one node of a cache cluster, identified the way ElastiCache publishes its
+// node metrics: by CacheClusterId and CacheNodeId.
+type CacheNode struct {
+	CacheClusterId string `json:"cacheClusterId"`
+	CacheNodeId    string `json:"cacheNodeId"`
+	// Role is primary or replica for Redis nodes and empty otherwise
+	Role string `json:"role,omitempty"`
+}
+
+// Name returns the key the node is reported under in the panel output.
+func (n CacheNode) Name() string {
+	return n.CacheClusterId + "/" + n.CacheNodeId
+}
+
+// GetElastiCacheClusterId returns the replication group or cache cluster from the
+// ReplicationGroupId flag, the CMDB element or the instanceId flag, in that order.
+func GetElastiCacheClusterId(cmd *cobra.Command) (string, error) {
+	replicationGroupId, _ := cmd.PersistentFlags().GetString("ReplicationGroupId")
+	elementId, _ := cmd.PersistentFlags().GetString("elementId")
+	cmdbApiUrl, _ := cmd.PersistentFlags().GetString("cmdbApiUrl")
+	instanceId, _ := cmd.PersistentFlags().GetString("instanceId")
+
+	if replicationGroupId != "" {
+		return replicationGroupId, nil
+	}
+
+	if elementId != "" {
+		log.Println("getting cloud-element data from cmdb")
+		apiUrl := cmdbApiUrl
+		if cmdbApiUrl == "" {
+			log.Println("using default cmdb url")
+			apiUrl = config.CmdbUrl
+		}
+		log.Println("cmdb url: " + apiUrl)
+		cmdbData, err := cmdb.GetCloudElementData(apiUrl, elementId)
+		if err != nil {
+			return "", err
+		}
+		instanceId = cmdbData.InstanceId
+	}
+
+	if instanceId == "" {
+		return "", errors.New("cluster id not provided. provide ReplicationGroupId or elementId")
+	}
+	return instanceId, nil
+}
+
+// GetCacheNodes returns the member nodes of the replication group. When no replication
+// group has the given id it is taken as a cache cluster id, which is how Memcached
+// clusters and standalone Redis nodes are addressed.
+func GetCacheNodes(clientAuth *model.Auth, clusterId string) ([]CacheNode, error) {
+	// awsclient has no ElastiCache client type, so the client is built on the assumed role session
+	elastiCacheClient := elasticache.New(awsclient.GetSessionWithAssumeRole(*clientAuth))
+
+	output, err := elastiCacheClient.DescribeReplicationGroups(&elasticache.DescribeReplicationGroupsInput{
+		ReplicationGroupId: aws.String(clusterId),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == elasticache.ErrCodeReplicationGroupNotFoundFault {
+			return getCacheClusterNodes(elastiCacheClient, clusterId)
+		}
+		return nil, err
+	}
+	if len(output.ReplicationGroups) == 0 {
+		return nil, fmt.Errorf("replication group %s not found", clusterId)
+	}
+
+	replicationGroup := output.ReplicationGroups[0]
+	var nodes []CacheNode
+	for _, nodeGroup := range replicationGroup.NodeGroups {
+		for _, member := range nodeGroup.NodeGroupMembers {
+			nodes = append(nodes, CacheNode{
+				CacheClusterId: aws.StringValue(member.CacheClusterId),
+				CacheNodeId:    aws.StringValue(member.CacheNodeId),
+				Role:           aws.StringValue(member.CurrentRole),
+			})
+		}
+	}
+	// cluster mode enabled groups do not list their members per node group
+	if len(nodes) == 0 {
+		for _, memberCluster := range replicationGroup.MemberClusters {
+			nodes = append(nodes, CacheNode{
+				CacheClusterId: aws.StringValue(memberCluster),
+				CacheNodeId:    "0001",
+			})
+		}
+	}
+	return nodes, nil
+}
+
+func getCacheClusterNodes(elastiCacheClient *elasticache.ElastiCache, cacheClusterId string) ([]CacheNode, error) {
+	output, err := elastiCacheClient.DescribeCacheClusters(&elasticache.DescribeCacheClustersInput{
+		CacheClusterId:    aws.String(cacheClusterId),
+		ShowCacheNodeInfo: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []CacheNode
+	for _, cacheCluster := range output.CacheClusters {
+		for _, cacheNode := range cacheCluster.CacheNodes {
+			nodes = append(nodes, CacheNode{
+				CacheClusterId: aws.StringValue(cacheCluster.CacheClusterId),
+				CacheNodeId:    aws.StringValue(cacheNode.CacheNodeId),
+			})
+		}
+	}
+	return nodes, nil
+}
@@ -0,0 +1,74 @@
+package ElastiCache
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var connectionsSeries = []Metric.Series{
+	{Label: "CurrConnections", MetricName: "CurrConnections", Statistic: "Average"},
+	{Label: "NewConnections", MetricName: "NewConnections", Statistic: "Sum"},
+}
+
+var AwsxElastiCacheConnectionsCmd = &cobra.Command{
+	Use:   "elasticache_connections_panel",
+	Short: "get connections of the cache nodes",
+	Long:  `command to get the current and new connections of every node of the cluster`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetElastiCacheConnectionsPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting elasticache connections: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetElastiCacheConnectionsPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetElastiCacheNodePanel(cmd, clientAuth, connectionsSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxElastiCacheConnectionsCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxElastiCacheConnectionsCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxElastiCacheConnectionsCmd.PersistentFlags().String("query", "", "query")
+	AwsxElastiCacheConnectionsCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxElastiCacheConnectionsCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxElastiCacheConnectionsCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxElastiCacheConnectionsCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxElastiCacheConnectionsCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxElastiCacheConnectionsCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxElastiCacheConnectionsCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxElastiCacheConnectionsCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxElastiCacheConnectionsCmd.PersistentFlags().String("ReplicationGroupId", "", "elasticache replication group or cache cluster id")
+	AwsxElastiCacheConnectionsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxElastiCacheConnectionsCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxElastiCacheConnectionsCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxElastiCacheConnectionsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
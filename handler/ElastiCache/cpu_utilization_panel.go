@@ -0,0 +1,74 @@
+package ElastiCache
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var cpuUtilizationSeries = []Metric.Series{
+	{Label: "EngineCPUUtilization", MetricName: "EngineCPUUtilization", Statistic: "Average"},
+	{Label: "CPUUtilization", MetricName: "CPUUtilization", Statistic: "Average"},
+}
+
+var AwsxElastiCacheCpuUtilizationCmd = &cobra.Command{
+	Use:   "elasticache_cpu_utilization_panel",
+	Short: "get cpu utilization of the cache nodes",
+	Long:  `command to get the engine and host cpu utilization of every node of the cluster`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetElastiCacheCpuUtilizationPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting elasticache cpu utilization: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetElastiCacheCpuUtilizationPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetElastiCacheNodePanel(cmd, clientAuth, cpuUtilizationSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxElastiCacheCpuUtilizationCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxElastiCacheCpuUtilizationCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxElastiCacheCpuUtilizationCmd.PersistentFlags().String("query", "", "query")
+	AwsxElastiCacheCpuUtilizationCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxElastiCacheCpuUtilizationCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxElastiCacheCpuUtilizationCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxElastiCacheCpuUtilizationCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxElastiCacheCpuUtilizationCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxElastiCacheCpuUtilizationCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxElastiCacheCpuUtilizationCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxElastiCacheCpuUtilizationCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxElastiCacheCpuUtilizationCmd.PersistentFlags().String("ReplicationGroupId", "", "elasticache replication group or cache cluster id")
+	AwsxElastiCacheCpuUtilizationCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxElastiCacheCpuUtilizationCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxElastiCacheCpuUtilizationCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxElastiCacheCpuUtilizationCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
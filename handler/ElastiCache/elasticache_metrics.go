@@ -0,0 +1,83 @@
+package ElastiCache
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+const namespace = "AWS/ElastiCache"
+
+// period is the granularity ElastiCache publishes its metrics at
+const period = 60
+
+// GetElastiCacheNodePanel resolves the cluster into its nodes and returns the given series
+// of every node, keyed by node.
+func GetElastiCacheNodePanel(cmd *cobra.Command, clientAuth *model.Auth, series []Metric.Series, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	result, cloudwatchMetricData, err := getNodeMetricData(cmd, clientAuth, series, cloudWatchClient)
+	if err != nil {
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func getNodeMetricData(cmd *cobra.Command, clientAuth *model.Auth, series []Metric.Series, cloudWatchClient *cloudwatch.CloudWatch) (map[string]map[string][]Metric.DataPoint, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	clusterId, err := GetElastiCacheClusterId(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nodes, err := GetCacheNodes(clientAuth, clusterId)
+	if err != nil {
+		log.Println("Error in getting cache nodes: ", err)
+		return nil, nil, err
+	}
+
+	result, cloudwatchMetricData, err := GetElastiCacheMetricData(clientAuth, nodes, series, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting cache node metric data: ", err)
+		return nil, nil, err
+	}
+	return result, cloudwatchMetricData, nil
+}
+
+// GetElastiCacheMetricData queries AWS/ElastiCache for every series of every node. Both
+// results are keyed by node name; the data points further by series label.
+func GetElastiCacheMetricData(clientAuth *model.Auth, nodes []CacheNode, series []Metric.Series, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (map[string]map[string][]Metric.DataPoint, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	entityDimensions := map[string][]*cloudwatch.Dimension{}
+	for _, node := range nodes {
+		entityDimensions[node.Name()] = nodeDimensions(node)
+	}
+	return Metric.GetEntityMetricData(clientAuth, namespace, period, entityDimensions, series, startTime, endTime, cloudWatchClient)
+}
+
+func nodeDimensions(node CacheNode) []*cloudwatch.Dimension {
+	return []*cloudwatch.Dimension{
+		{
+			Name:  aws.String("CacheClusterId"),
+			Value: aws.String(node.CacheClusterId),
+		},
+		{
+			Name:  aws.String("CacheNodeId"),
+			Value: aws.String(node.CacheNodeId),
+		},
+	}
+}
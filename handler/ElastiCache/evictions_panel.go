@@ -0,0 +1,73 @@
+package ElastiCache
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var evictionsSeries = []Metric.Series{
+	{Label: "Evictions", MetricName: "Evictions", Statistic: "Sum"},
+}
+
+var AwsxElastiCacheEvictionsCmd = &cobra.Command{
+	Use:   "elasticache_evictions_panel",
+	Short: "get evictions of the cache nodes",
+	Long:  `command to get the keys evicted because of the maxmemory limit on every node of the cluster`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetElastiCacheEvictionsPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting elasticache evictions: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetElastiCacheEvictionsPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetElastiCacheNodePanel(cmd, clientAuth, evictionsSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxElastiCacheEvictionsCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxElastiCacheEvictionsCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxElastiCacheEvictionsCmd.PersistentFlags().String("query", "", "query")
+	AwsxElastiCacheEvictionsCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxElastiCacheEvictionsCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxElastiCacheEvictionsCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxElastiCacheEvictionsCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxElastiCacheEvictionsCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxElastiCacheEvictionsCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxElastiCacheEvictionsCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxElastiCacheEvictionsCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxElastiCacheEvictionsCmd.PersistentFlags().String("ReplicationGroupId", "", "elasticache replication group or cache cluster id")
+	AwsxElastiCacheEvictionsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxElastiCacheEvictionsCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxElastiCacheEvictionsCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxElastiCacheEvictionsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
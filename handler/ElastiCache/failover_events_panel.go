@@ -0,0 +1,127 @@
+package ElastiCache
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+// FailoverEvent is a change of the IsMaster metric of a node
+type FailoverEvent struct {
+	Timestamp time.Time `json:"timestamp"`
+	// Role is the role the node took over, primary or replica
+	Role string `json:"role"`
+}
+
+type NodeFailovers struct {
+	IsMaster  []Metric.DataPoint `json:"isMaster"`
+	Failovers []FailoverEvent    `json:"failovers"`
+}
+
+var isMasterSeries = []Metric.Series{
+	{Label: "IsMaster", MetricName: "IsMaster", Statistic: "Maximum"},
+}
+
+var AwsxElastiCacheFailoverEventsCmd = &cobra.Command{
+	Use:   "elasticache_failover_events_panel",
+	Short: "get failover events of the cache nodes",
+	Long:  `command to get the IsMaster metric of every node of the cluster and the failovers it shows`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetElastiCacheFailoverEventsPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting elasticache failover events: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetElastiCacheFailoverEventsPanel returns the IsMaster metric of every node together with
+// the points where it changed, which is where a failover promoted or demoted the node.
+func GetElastiCacheFailoverEventsPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	result, cloudwatchMetricData, err := getNodeMetricData(cmd, clientAuth, isMasterSeries, cloudWatchClient)
+	if err != nil {
+		return "", nil, err
+	}
+
+	failovers := map[string]NodeFailovers{}
+	for node, nodeResult := range result {
+		isMaster := nodeResult["IsMaster"]
+		failovers[node] = NodeFailovers{
+			IsMaster:  isMaster,
+			Failovers: detectFailovers(isMaster),
+		}
+	}
+
+	jsonString, err := json.Marshal(failovers)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func detectFailovers(isMaster []Metric.DataPoint) []FailoverEvent {
+	failovers := []FailoverEvent{}
+	for i := 1; i < len(isMaster); i++ {
+		if isMaster[i].Value == isMaster[i-1].Value {
+			continue
+		}
+		role := "replica"
+		if isMaster[i].Value > 0 {
+			role = "primary"
+		}
+		failovers = append(failovers, FailoverEvent{
+			Timestamp: isMaster[i].Timestamp,
+			Role:      role,
+		})
+	}
+	return failovers
+}
+
+func init() {
+	AwsxElastiCacheFailoverEventsCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxElastiCacheFailoverEventsCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxElastiCacheFailoverEventsCmd.PersistentFlags().String("query", "", "query")
+	AwsxElastiCacheFailoverEventsCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxElastiCacheFailoverEventsCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxElastiCacheFailoverEventsCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxElastiCacheFailoverEventsCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxElastiCacheFailoverEventsCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxElastiCacheFailoverEventsCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxElastiCacheFailoverEventsCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxElastiCacheFailoverEventsCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxElastiCacheFailoverEventsCmd.PersistentFlags().String("ReplicationGroupId", "", "elasticache replication group or cache cluster id")
+	AwsxElastiCacheFailoverEventsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxElastiCacheFailoverEventsCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxElastiCacheFailoverEventsCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxElastiCacheFailoverEventsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
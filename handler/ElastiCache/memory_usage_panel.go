@@ -0,0 +1,74 @@
+package ElastiCache
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var memoryUsageSeries = []Metric.Series{
+	{Label: "DatabaseMemoryUsagePercentage", MetricName: "DatabaseMemoryUsagePercentage", Statistic: "Average"},
+	{Label: "FreeableMemory", MetricName: "FreeableMemory", Statistic: "Average"},
+}
+
+var AwsxElastiCacheMemoryUsageCmd = &cobra.Command{
+	Use:   "elasticache_memory_usage_panel",
+	Short: "get memory usage of the cache nodes",
+	Long:  `command to get the database memory usage percentage and freeable memory of every node of the cluster`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetElastiCacheMemoryUsagePanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting elasticache memory usage: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetElastiCacheMemoryUsagePanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetElastiCacheNodePanel(cmd, clientAuth, memoryUsageSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxElastiCacheMemoryUsageCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxElastiCacheMemoryUsageCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxElastiCacheMemoryUsageCmd.PersistentFlags().String("query", "", "query")
+	AwsxElastiCacheMemoryUsageCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxElastiCacheMemoryUsageCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxElastiCacheMemoryUsageCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxElastiCacheMemoryUsageCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxElastiCacheMemoryUsageCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxElastiCacheMemoryUsageCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxElastiCacheMemoryUsageCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxElastiCacheMemoryUsageCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxElastiCacheMemoryUsageCmd.PersistentFlags().String("ReplicationGroupId", "", "elasticache replication group or cache cluster id")
+	AwsxElastiCacheMemoryUsageCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxElastiCacheMemoryUsageCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxElastiCacheMemoryUsageCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxElastiCacheMemoryUsageCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
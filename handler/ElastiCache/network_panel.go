@@ -0,0 +1,74 @@
+package ElastiCache
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var networkSeries = []Metric.Series{
+	{Label: "NetworkBytesIn", MetricName: "NetworkBytesIn", Statistic: "Sum"},
+	{Label: "NetworkBytesOut", MetricName: "NetworkBytesOut", Statistic: "Sum"},
+}
+
+var AwsxElastiCacheNetworkCmd = &cobra.Command{
+	Use:   "elasticache_network_panel",
+	Short: "get network bytes of the cache nodes",
+	Long:  `command to get the network bytes in and out of every node of the cluster`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetElastiCacheNetworkPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting elasticache network: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetElastiCacheNetworkPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetElastiCacheNodePanel(cmd, clientAuth, networkSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxElastiCacheNetworkCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxElastiCacheNetworkCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxElastiCacheNetworkCmd.PersistentFlags().String("query", "", "query")
+	AwsxElastiCacheNetworkCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxElastiCacheNetworkCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxElastiCacheNetworkCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxElastiCacheNetworkCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxElastiCacheNetworkCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxElastiCacheNetworkCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxElastiCacheNetworkCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxElastiCacheNetworkCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxElastiCacheNetworkCmd.PersistentFlags().String("ReplicationGroupId", "", "elasticache replication group or cache cluster id")
+	AwsxElastiCacheNetworkCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxElastiCacheNetworkCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxElastiCacheNetworkCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxElastiCacheNetworkCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,73 @@
+package ElastiCache
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var replicationLagSeries = []Metric.Series{
+	{Label: "ReplicationLag", MetricName: "ReplicationLag", Statistic: "Maximum"},
+}
+
+var AwsxElastiCacheReplicationLagCmd = &cobra.Command{
+	Use:   "elasticache_replication_lag_panel",
+	Short: "get replication lag of the cache nodes",
+	Long:  `command to get the replication lag of every replica node of the cluster. primaries report no data`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetElastiCacheReplicationLagPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting elasticache replication lag: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetElastiCacheReplicationLagPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetElastiCacheNodePanel(cmd, clientAuth, replicationLagSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxElastiCacheReplicationLagCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxElastiCacheReplicationLagCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxElastiCacheReplicationLagCmd.PersistentFlags().String("query", "", "query")
+	AwsxElastiCacheReplicationLagCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxElastiCacheReplicationLagCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxElastiCacheReplicationLagCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxElastiCacheReplicationLagCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxElastiCacheReplicationLagCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxElastiCacheReplicationLagCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxElastiCacheReplicationLagCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxElastiCacheReplicationLagCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxElastiCacheReplicationLagCmd.PersistentFlags().String("ReplicationGroupId", "", "elasticache replication group or cache cluster id")
+	AwsxElastiCacheReplicationLagCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxElastiCacheReplicationLagCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxElastiCacheReplicationLagCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxElastiCacheReplicationLagCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,186 @@
+package Kinesis
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// shardIngressLimit is the write limit of a shard in bytes per second (1 MB/s)
+const shardIngressLimit = 1024 * 1024
+
+type ShardIngress struct {
+	ShardId               string  `json:"shardId"`
+	PeakBytesPerSecond    float64 `json:"peakBytesPerSecond"`
+	AverageBytesPerSecond float64 `json:"averageBytesPerSecond"`
+	// PeakUtilization is the peak ingress as a percentage of the 1 MB/s shard limit
+	PeakUtilization float64 `json:"peakUtilization"`
+}
+
+var incomingBytesSeries = []Metric.Series{
+	{Label: "IncomingBytes", MetricName: "IncomingBytes", Statistic: "Sum"},
+}
+
+var AwsxKinesisHotShardsCmd = &cobra.Command{
+	Use:   "kinesis_hot_shards_panel",
+	Short: "get the hot shards of the stream",
+	Long:  `command to rank the shards of the stream by their ingress against the 1 MB/s per-shard limit`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, table, err := GetKinesisHotShardsPanel(cmd, clientAuth)
+			if err != nil {
+				log.Println("Error getting kinesis hot shards: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(table)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetKinesisHotShardsPanel ranks the open shards of the stream by their peak ingress
+// against the 1 MB/s per-shard limit, from the shard-level IncomingBytes metric. Without
+// startTime the last hour is used.
+func GetKinesisHotShardsPanel(cmd *cobra.Command, clientAuth *model.Auth) (string, string, error) {
+	streamName, err := GetKinesisStreamName(cmd)
+	if err != nil {
+		return "", "", err
+	}
+
+	metrics, err := GetShardLevelMetrics(clientAuth, streamName)
+	if err != nil {
+		log.Println("Error getting shard level metrics: ", err)
+		return "", "", err
+	}
+	enabled := false
+	for _, metric := range metrics {
+		if metric == "IncomingBytes" {
+			enabled = true
+		}
+	}
+	if !enabled {
+		return "", "", fmt.Errorf("shard-level IncomingBytes is not enabled for stream %s", streamName)
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, time.Hour)
+	if err != nil {
+		return "", "", err
+	}
+
+	shardIds, err := GetOpenShards(clientAuth, streamName)
+	if err != nil {
+		log.Println("Error listing shards: ", err)
+		return "", "", err
+	}
+
+	result, _, err := Metric.GetEntityMetricData(clientAuth, namespace, period, shardDimensions(streamName, shardIds), incomingBytesSeries, startTime, endTime, nil)
+	if err != nil {
+		log.Println("Error in getting shard metric data: ", err)
+		return "", "", err
+	}
+
+	shardIngress := rankShardIngress(result, endTime.Sub(*startTime))
+
+	jsonString, err := json.Marshal(shardIngress)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", "", err
+	}
+	return string(jsonString), printHotShardsTable(shardIngress), nil
+}
+
+// rankShardIngress turns the per minute IncomingBytes sums of every shard into rates and
+// orders the shards by peak utilization, highest first.
+func rankShardIngress(result map[string]map[string][]Metric.DataPoint, window time.Duration) []ShardIngress {
+	shardIngress := []ShardIngress{}
+	for shardId, shardResult := range result {
+		ingress := ShardIngress{ShardId: shardId}
+		var total float64
+		for _, point := range shardResult["IncomingBytes"] {
+			total += point.Value
+			if rate := point.Value / period; rate > ingress.PeakBytesPerSecond {
+				ingress.PeakBytesPerSecond = rate
+			}
+		}
+		if window > 0 {
+			ingress.AverageBytesPerSecond = total / window.Seconds()
+		}
+		ingress.PeakUtilization = ingress.PeakBytesPerSecond / shardIngressLimit * 100
+		shardIngress = append(shardIngress, ingress)
+	}
+	sort.Slice(shardIngress, func(i, j int) bool {
+		if shardIngress[i].PeakUtilization != shardIngress[j].PeakUtilization {
+			return shardIngress[i].PeakUtilization > shardIngress[j].PeakUtilization
+		}
+		return shardIngress[i].ShardId < shardIngress[j].ShardId
+	})
+	return shardIngress
+}
+
+func printHotShardsTable(shardIngress []ShardIngress) string {
+	var buffer bytes.Buffer
+	if len(shardIngress) == 0 {
+		buffer.WriteString("No shards found.")
+		return buffer.String()
+	}
+
+	table := tablewriter.NewWriter(&buffer)
+	table.SetHeader([]string{"Rank", "Shard ID", "Peak Bytes/s", "Average Bytes/s", "Peak Utilization (%)"})
+	for i, ingress := range shardIngress {
+		table.Append([]string{
+			strconv.Itoa(i + 1),
+			ingress.ShardId,
+			fmt.Sprintf("%.0f", ingress.PeakBytesPerSecond),
+			fmt.Sprintf("%.0f", ingress.AverageBytesPerSecond),
+			fmt.Sprintf("%.1f", ingress.PeakUtilization),
+		})
+	}
+	table.Render()
+	return buffer.String()
+}
+
+func init() {
+	AwsxKinesisHotShardsCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxKinesisHotShardsCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxKinesisHotShardsCmd.PersistentFlags().String("query", "", "query")
+	AwsxKinesisHotShardsCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxKinesisHotShardsCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxKinesisHotShardsCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxKinesisHotShardsCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxKinesisHotShardsCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxKinesisHotShardsCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxKinesisHotShardsCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxKinesisHotShardsCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxKinesisHotShardsCmd.PersistentFlags().String("StreamName", "", "kinesis stream name")
+	AwsxKinesisHotShardsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxKinesisHotShardsCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxKinesisHotShardsCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxKinesisHotShardsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
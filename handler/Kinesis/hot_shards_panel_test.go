@@ -0,0 +1,94 @@
+package Kinesis
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+)
+
+var windowStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// incomingBytes returns the IncomingBytes result of a shard with one per minute sum per value
+func incomingBytes(sums ...float64) map[string][]Metric.DataPoint {
+	points := []Metric.DataPoint{}
+	for i, sum := range sums {
+		points = append(points, Metric.DataPoint{Timestamp: windowStart.Add(time.Duration(i) * time.Minute), Value: sum})
+	}
+	return map[string][]Metric.DataPoint{"IncomingBytes": points}
+}
+
+func TestRankShardIngress(t *testing.T) {
+	const mib = 1024 * 1024
+
+	tests := []struct {
+		name   string
+		result map[string]map[string][]Metric.DataPoint
+		window time.Duration
+		want   []ShardIngress
+	}{
+		{
+			name:   "no shards",
+			result: map[string]map[string][]Metric.DataPoint{},
+			window: time.Hour,
+			want:   []ShardIngress{},
+		},
+		{
+			name: "ranked by peak utilization",
+			result: map[string]map[string][]Metric.DataPoint{
+				"shard-1": incomingBytes(30*mib, 15*mib),
+				"shard-2": incomingBytes(60*mib, 0),
+				"shard-3": incomingBytes(90*mib, 30*mib),
+			},
+			window: 2 * time.Minute,
+			want: []ShardIngress{
+				{ShardId: "shard-3", PeakBytesPerSecond: 1.5 * mib, AverageBytesPerSecond: mib, PeakUtilization: 150},
+				{ShardId: "shard-2", PeakBytesPerSecond: mib, AverageBytesPerSecond: 0.5 * mib, PeakUtilization: 100},
+				{ShardId: "shard-1", PeakBytesPerSecond: 0.5 * mib, AverageBytesPerSecond: 0.375 * mib, PeakUtilization: 50},
+			},
+		},
+		{
+			name: "ties broken by shard id",
+			result: map[string]map[string][]Metric.DataPoint{
+				"shard-b": incomingBytes(6 * mib),
+				"shard-c": incomingBytes(6 * mib),
+				"shard-a": incomingBytes(6 * mib),
+			},
+			window: time.Minute,
+			want: []ShardIngress{
+				{ShardId: "shard-a", PeakBytesPerSecond: 0.1 * mib, AverageBytesPerSecond: 0.1 * mib, PeakUtilization: 10},
+				{ShardId: "shard-b", PeakBytesPerSecond: 0.1 * mib, AverageBytesPerSecond: 0.1 * mib, PeakUtilization: 10},
+				{ShardId: "shard-c", PeakBytesPerSecond: 0.1 * mib, AverageBytesPerSecond: 0.1 * mib, PeakUtilization: 10},
+			},
+		},
+		{
+			name: "average over the whole window",
+			result: map[string]map[string][]Metric.DataPoint{
+				"shard-1": incomingBytes(60 * mib),
+			},
+			window: 4 * time.Minute,
+			want: []ShardIngress{
+				{ShardId: "shard-1", PeakBytesPerSecond: mib, AverageBytesPerSecond: 0.25 * mib, PeakUtilization: 100},
+			},
+		},
+		{
+			name: "shard without data",
+			result: map[string]map[string][]Metric.DataPoint{
+				"shard-1": incomingBytes(),
+			},
+			window: time.Hour,
+			want: []ShardIngress{
+				{ShardId: "shard-1"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := rankShardIngress(test.result, test.window)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("rankShardIngress = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package Kinesis
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var incomingDataSeries = []Metric.Series{
+	{Label: "IncomingRecords", MetricName: "IncomingRecords", Statistic: "Sum"},
+	{Label: "IncomingBytes", MetricName: "IncomingBytes", Statistic: "Sum"},
+}
+
+var AwsxKinesisIncomingDataCmd = &cobra.Command{
+	Use:   "kinesis_incoming_data_panel",
+	Short: "get incoming records and bytes of the stream",
+	Long:  `command to get the records and bytes put into the stream`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetKinesisIncomingDataPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting kinesis incoming data: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetKinesisIncomingDataPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetKinesisStreamPanel(cmd, clientAuth, incomingDataSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxKinesisIncomingDataCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxKinesisIncomingDataCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxKinesisIncomingDataCmd.PersistentFlags().String("query", "", "query")
+	AwsxKinesisIncomingDataCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxKinesisIncomingDataCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxKinesisIncomingDataCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxKinesisIncomingDataCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxKinesisIncomingDataCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxKinesisIncomingDataCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxKinesisIncomingDataCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxKinesisIncomingDataCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxKinesisIncomingDataCmd.PersistentFlags().String("StreamName", "", "kinesis stream name")
+	AwsxKinesisIncomingDataCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxKinesisIncomingDataCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxKinesisIncomingDataCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxKinesisIncomingDataCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,73 @@
+package Kinesis
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var iteratorAgeSeries = []Metric.Series{
+	{Label: "GetRecords.IteratorAgeMilliseconds", MetricName: "GetRecords.IteratorAgeMilliseconds", Statistic: "Maximum"},
+}
+
+var AwsxKinesisIteratorAgeCmd = &cobra.Command{
+	Use:   "kinesis_iterator_age_panel",
+	Short: "get iterator age of the stream",
+	Long:  `command to get the age of the last record read by GetRecords, which shows how far consumers are behind`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetKinesisIteratorAgePanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting kinesis iterator age: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetKinesisIteratorAgePanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetKinesisStreamPanel(cmd, clientAuth, iteratorAgeSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxKinesisIteratorAgeCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxKinesisIteratorAgeCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxKinesisIteratorAgeCmd.PersistentFlags().String("query", "", "query")
+	AwsxKinesisIteratorAgeCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxKinesisIteratorAgeCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxKinesisIteratorAgeCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxKinesisIteratorAgeCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxKinesisIteratorAgeCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxKinesisIteratorAgeCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxKinesisIteratorAgeCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxKinesisIteratorAgeCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxKinesisIteratorAgeCmd.PersistentFlags().String("StreamName", "", "kinesis stream name")
+	AwsxKinesisIteratorAgeCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxKinesisIteratorAgeCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxKinesisIteratorAgeCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxKinesisIteratorAgeCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,62 @@
+package Kinesis
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+const namespace = "AWS/Kinesis"
+
+// period is the granularity Kinesis publishes its metrics at
+const period = 60
+
+// GetKinesisStreamPanel resolves the stream and returns the given series of it.
+func GetKinesisStreamPanel(cmd *cobra.Command, clientAuth *model.Auth, series []Metric.Series, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	streamName, err := GetKinesisStreamName(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result, cloudwatchMetricData, err := Metric.GetMetricData(clientAuth, namespace, period, streamDimensions(streamName), series, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting stream metric data: ", err)
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func streamDimensions(streamName string) []*cloudwatch.Dimension {
+	return Metric.Dimensions("StreamName", streamName)
+}
+
+// shardDimensions returns the dimensions of the shard-level metrics of every shard, keyed
+// by shard id.
+func shardDimensions(streamName string, shardIds []string) map[string][]*cloudwatch.Dimension {
+	dimensions := map[string][]*cloudwatch.Dimension{}
+	for _, shardId := range shardIds {
+		dimensions[shardId] = append(streamDimensions(streamName), &cloudwatch.Dimension{
+			Name:  aws.String("ShardId"),
+			Value: aws.String(shardId),
+		})
+	}
+	return dimensions
+}
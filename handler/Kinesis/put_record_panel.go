@@ -0,0 +1,76 @@
+package Kinesis
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var putRecordSeries = []Metric.Series{
+	{Label: "PutRecord.Success", MetricName: "PutRecord.Success", Statistic: "Average"},
+	{Label: "PutRecord.Latency", MetricName: "PutRecord.Latency", Statistic: "Average"},
+	{Label: "PutRecords.Success", MetricName: "PutRecords.Success", Statistic: "Average"},
+	{Label: "PutRecords.Latency", MetricName: "PutRecords.Latency", Statistic: "Average"},
+}
+
+var AwsxKinesisPutRecordCmd = &cobra.Command{
+	Use:   "kinesis_put_record_panel",
+	Short: "get put record success and latency of the stream",
+	Long:  `command to get the success rate and latency of the PutRecord and PutRecords calls of the stream`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetKinesisPutRecordPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting kinesis put record: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetKinesisPutRecordPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetKinesisStreamPanel(cmd, clientAuth, putRecordSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxKinesisPutRecordCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxKinesisPutRecordCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxKinesisPutRecordCmd.PersistentFlags().String("query", "", "query")
+	AwsxKinesisPutRecordCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxKinesisPutRecordCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxKinesisPutRecordCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxKinesisPutRecordCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxKinesisPutRecordCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxKinesisPutRecordCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxKinesisPutRecordCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxKinesisPutRecordCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxKinesisPutRecordCmd.PersistentFlags().String("StreamName", "", "kinesis stream name")
+	AwsxKinesisPutRecordCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxKinesisPutRecordCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxKinesisPutRecordCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxKinesisPutRecordCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
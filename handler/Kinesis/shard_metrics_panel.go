@@ -0,0 +1,127 @@
+package Kinesis
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+// shardMetricStatistics is the statistic each shard-level metric is returned with
+var shardMetricStatistics = map[string]string{
+	"IncomingBytes":                      "Sum",
+	"IncomingRecords":                    "Sum",
+	"OutgoingBytes":                      "Sum",
+	"OutgoingRecords":                    "Sum",
+	"ReadProvisionedThroughputExceeded":  "Sum",
+	"WriteProvisionedThroughputExceeded": "Sum",
+	"IteratorAgeMilliseconds":            "Maximum",
+}
+
+var AwsxKinesisShardMetricsCmd = &cobra.Command{
+	Use:   "kinesis_shard_metrics_panel",
+	Short: "get enhanced shard-level metrics of the stream",
+	Long:  `command to get the enhanced shard-level metrics enabled on the stream for every open shard`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetKinesisShardMetricsPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting kinesis shard metrics: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetKinesisShardMetricsPanel returns the shard-level metrics enabled on the stream for
+// every open shard, keyed by shard id. It fails when enhanced monitoring is off.
+func GetKinesisShardMetricsPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	streamName, err := GetKinesisStreamName(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	metrics, err := GetShardLevelMetrics(clientAuth, streamName)
+	if err != nil {
+		log.Println("Error getting shard level metrics: ", err)
+		return "", nil, err
+	}
+	var series []Metric.Series
+	for _, metric := range metrics {
+		if statistic, ok := shardMetricStatistics[metric]; ok {
+			series = append(series, Metric.Series{Label: metric, MetricName: metric, Statistic: statistic})
+		}
+	}
+	if len(series) == 0 {
+		return "", nil, fmt.Errorf("enhanced shard-level monitoring is not enabled for stream %s", streamName)
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	shardIds, err := GetOpenShards(clientAuth, streamName)
+	if err != nil {
+		log.Println("Error listing shards: ", err)
+		return "", nil, err
+	}
+
+	result, cloudwatchMetricData, err := Metric.GetEntityMetricData(clientAuth, namespace, period, shardDimensions(streamName, shardIds), series, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting shard metric data: ", err)
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func init() {
+	AwsxKinesisShardMetricsCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxKinesisShardMetricsCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxKinesisShardMetricsCmd.PersistentFlags().String("query", "", "query")
+	AwsxKinesisShardMetricsCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxKinesisShardMetricsCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxKinesisShardMetricsCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxKinesisShardMetricsCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxKinesisShardMetricsCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxKinesisShardMetricsCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxKinesisShardMetricsCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxKinesisShardMetricsCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxKinesisShardMetricsCmd.PersistentFlags().String("StreamName", "", "kinesis stream name")
+	AwsxKinesisShardMetricsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxKinesisShardMetricsCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxKinesisShardMetricsCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxKinesisShardMetricsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,95 @@
+package Kinesis
+
+import (
+	"errors"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/cmdb"
+	"github.com/Appkube-awsx/awsx-common/config"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/spf13/cobra"
+)
+
+// GetKinesisStreamName returns the stream from the StreamName flag, the CMDB element or
+// the instanceId flag, in that order.
+func GetKinesisStreamName(cmd *cobra.Command) (string, error) {
+	streamName, _ := cmd.PersistentFlags().GetString("StreamName")
+	elementId, _ := cmd.PersistentFlags().GetString("elementId")
+	cmdbApiUrl, _ := cmd.PersistentFlags().GetString("cmdbApiUrl")
+	instanceId, _ := cmd.PersistentFlags().GetString("instanceId")
+
+	if streamName != "" {
+		return streamName, nil
+	}
+
+	if elementId != "" {
+		log.Println("getting cloud-element data from cmdb")
+		apiUrl := cmdbApiUrl
+		if cmdbApiUrl == "" {
+			log.Println("using default cmdb url")
+			apiUrl = config.CmdbUrl
+		}
+		log.Println("cmdb url: " + apiUrl)
+		cmdbData, err := cmdb.GetCloudElementData(apiUrl, elementId)
+		if err != nil {
+			return "", err
+		}
+		instanceId = cmdbData.InstanceId
+	}
+
+	if instanceId == "" {
+		return "", errors.New("stream name not provided. provide StreamName or elementId")
+	}
+	return instanceId, nil
+}
+
+// GetShardLevelMetrics returns the shard-level metrics enabled on the stream by enhanced
+// monitoring, with ALL expanded by Kinesis into the individual metric names.
+func GetShardLevelMetrics(clientAuth *model.Auth, streamName string) ([]string, error) {
+	kinesisClient := awsclient.GetClient(*clientAuth, awsclient.KINESIS_CLIENT).(*kinesis.Kinesis)
+	output, err := kinesisClient.DescribeStreamSummary(&kinesis.DescribeStreamSummaryInput{
+		StreamName: aws.String(streamName),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var metrics []string
+	for _, enhancedMetrics := range output.StreamDescriptionSummary.EnhancedMonitoring {
+		for _, metric := range enhancedMetrics.ShardLevelMetrics {
+			if aws.StringValue(metric) != kinesis.MetricsNameAll {
+				metrics = append(metrics, aws.StringValue(metric))
+			}
+		}
+	}
+	return metrics, nil
+}
+
+// GetOpenShards returns the ids of the open shards of the stream.
+func GetOpenShards(clientAuth *model.Auth, streamName string) ([]string, error) {
+	kinesisClient := awsclient.GetClient(*clientAuth, awsclient.KINESIS_CLIENT).(*kinesis.Kinesis)
+
+	var shardIds []string
+	input := &kinesis.ListShardsInput{
+		StreamName:  aws.String(streamName),
+		ShardFilter: &kinesis.ShardFilter{Type: aws.String(kinesis.ShardFilterTypeAtLatest)},
+	}
+	for {
+		output, err := kinesisClient.ListShards(input)
+		if err != nil {
+			return nil, err
+		}
+		for _, shard := range output.Shards {
+			shardIds = append(shardIds, aws.StringValue(shard.ShardId))
+		}
+		if output.NextToken == nil {
+			break
+		}
+		// the stream name must not be repeated with a NextToken
+		input = &kinesis.ListShardsInput{NextToken: output.NextToken}
+	}
+	return shardIds, nil
+}
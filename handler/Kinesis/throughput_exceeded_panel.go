@@ -0,0 +1,74 @@
+package Kinesis
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var throughputExceededSeries = []Metric.Series{
+	{Label: "ReadProvisionedThroughputExceeded", MetricName: "ReadProvisionedThroughputExceeded", Statistic: "Sum"},
+	{Label: "WriteProvisionedThroughputExceeded", MetricName: "WriteProvisionedThroughputExceeded", Statistic: "Sum"},
+}
+
+var AwsxKinesisThroughputExceededCmd = &cobra.Command{
+	Use:   "kinesis_throughput_exceeded_panel",
+	Short: "get throttled reads and writes of the stream",
+	Long:  `command to get the GetRecords calls and records rejected for exceeding the stream throughput`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetKinesisThroughputExceededPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting kinesis throughput exceeded: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetKinesisThroughputExceededPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetKinesisStreamPanel(cmd, clientAuth, throughputExceededSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxKinesisThroughputExceededCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxKinesisThroughputExceededCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxKinesisThroughputExceededCmd.PersistentFlags().String("query", "", "query")
+	AwsxKinesisThroughputExceededCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxKinesisThroughputExceededCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxKinesisThroughputExceededCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxKinesisThroughputExceededCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxKinesisThroughputExceededCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxKinesisThroughputExceededCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxKinesisThroughputExceededCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxKinesisThroughputExceededCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxKinesisThroughputExceededCmd.PersistentFlags().String("StreamName", "", "kinesis stream name")
+	AwsxKinesisThroughputExceededCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxKinesisThroughputExceededCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxKinesisThroughputExceededCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxKinesisThroughputExceededCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
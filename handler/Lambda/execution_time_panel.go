@@ -7,9 +7,9 @@ import (
 	"time"
 
 	"github.com/Appkube-awsx/awsx-common/authenticate"
-	"github.com/Appkube-awsx/awsx-common/awsclient"
 	"github.com/Appkube-awsx/awsx-common/model"
-	"github.com/aws/aws-sdk-go/aws"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Statistic"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/spf13/cobra"
 )
@@ -63,15 +63,36 @@ func GetLambdaExecutionTimePanel(cmd *cobra.Command, clientAuth *model.Auth, clo
 		return "", nil, err
 	}
 
-	cloudwatchMetricData := make(map[string]*cloudwatch.GetMetricDataOutput)
+	statistics, err := Statistic.GetStatistics(cmd)
+	if err != nil {
+		return "", nil, err
+	}
 
-	rawData, err := GetLambdaExecutionTimeMetricData(clientAuth, startTime, endTime, cloudWatchClient, functionName)
+	if statistics != nil {
+		rawData, cloudwatchMetricData, err := GetLambdaExecutionTimeMetricData(clientAuth, startTime, endTime, cloudWatchClient, functionName, statistics)
+		if err != nil {
+			return "", nil, err
+		}
+		results := map[string][]*ExecutionTimeData{}
+		for statistic, points := range rawData {
+			results[statistic] = processExecutionTimeRawData(points, functionName)
+		}
+		jsonString, err := json.Marshal(results)
+		if err != nil {
+			return "", nil, err
+		}
+		return string(jsonString), &cloudwatchMetricData, nil
+	}
+
+	rawData, metricData, err := GetLambdaExecutionTimeMetricData(clientAuth, startTime, endTime, cloudWatchClient, functionName, []string{"Average"})
 	if err != nil {
 		return "", nil, err
 	}
-	cloudwatchMetricData[functionName] = rawData
+	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{
+		functionName: metricData["Average"],
+	}
 
-	processedDataList := processExecutionTimeRawData(rawData, functionName)
+	processedDataList := processExecutionTimeRawData(rawData["Average"], functionName)
 
 	jsonString, err := json.Marshal(processedDataList)
 	if err != nil {
@@ -80,80 +101,25 @@ func GetLambdaExecutionTimePanel(cmd *cobra.Command, clientAuth *model.Auth, clo
 	return string(jsonString), &cloudwatchMetricData, nil
 }
 
-func GetLambdaExecutionTimeMetricData(clientAuth *model.Auth, startTime, endTime time.Time, cloudWatchClient *cloudwatch.CloudWatch, functionName string) (*cloudwatch.GetMetricDataOutput, error) {
-	input := &cloudwatch.GetMetricDataInput{
-		StartTime: &startTime,
-		EndTime:   &endTime,
-		MetricDataQueries: []*cloudwatch.MetricDataQuery{
-			{
-				Id: aws.String("m1"),
-				MetricStat: &cloudwatch.MetricStat{
-					Metric: &cloudwatch.Metric{
-						MetricName: aws.String("Duration"),
-						Namespace:  aws.String("AWS/Lambda"),
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("FunctionName"),
-								Value: aws.String(functionName),
-							},
-						},
-					},
-					Period: aws.Int64(300), // 5 minutes
-					Stat:   aws.String("Average"),
-				},
-			},
-			{
-				Id: aws.String("m2"),
-				MetricStat: &cloudwatch.MetricStat{
-					Metric: &cloudwatch.Metric{
-						MetricName: aws.String("Duration"),
-						Namespace:  aws.String("AWS/Lambda"),
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("FunctionName"),
-								Value: aws.String(functionName),
-							},
-						},
-					},
-					Period: aws.Int64(300), // 5 minutes
-					Stat:   aws.String("Average"),
-				},
-			},
-		},
+// GetLambdaExecutionTimeMetricData returns Duration of the function for every statistic,
+// keyed by statistic, in a single request.
+func GetLambdaExecutionTimeMetricData(clientAuth *model.Auth, startTime, endTime time.Time, cloudWatchClient *cloudwatch.CloudWatch, functionName string, statistics []string) (map[string][]Metric.DataPoint, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	var series []Metric.Series
+	for _, statistic := range statistics {
+		series = append(series, Metric.Series{Label: statistic, MetricName: "Duration", Statistic: statistic})
 	}
-
-	if cloudWatchClient == nil {
-		cloudWatchClient = awsclient.GetClient(*clientAuth, awsclient.CLOUDWATCH).(*cloudwatch.CloudWatch)
-	}
-	result, err := cloudWatchClient.GetMetricData(input)
-	if err != nil {
-		return nil, err
-	}
-
-	return result, nil
+	return Metric.GetMetricData(clientAuth, "AWS/Lambda", 300, Metric.Dimensions("FunctionName", functionName), series, &startTime, &endTime, cloudWatchClient)
 }
 
-func processExecutionTimeRawData(result *cloudwatch.GetMetricDataOutput, functionName string) []*ExecutionTimeData {
+func processExecutionTimeRawData(points []Metric.DataPoint, functionName string) []*ExecutionTimeData {
 	var executionTimeDataList []*ExecutionTimeData
 
-	if len(result.MetricDataResults) == 0 {
-		return executionTimeDataList
-	}
-
-	numDataPoints := len(result.MetricDataResults[0].Timestamps)
-	if numDataPoints == 0 {
-		return executionTimeDataList
-	}
-
-	for i := 0; i < numDataPoints; i++ {
+	for _, point := range points {
 		executionTimeData := &ExecutionTimeData{}
 
-		duration := *result.MetricDataResults[0].Values[i]
-		// initDuration := *result.MetricDataResults[1].Values[i]
-
 		executionTimeData.FunctionName = functionName
-		executionTimeData.ResponseTime = duration
-		executionTimeData.Duration = duration
+		executionTimeData.ResponseTime = point.Value
+		executionTimeData.Duration = point.Value
 
 		executionTimeDataList = append(executionTimeDataList, executionTimeData)
 	}
@@ -190,4 +156,5 @@ func init() {
 	LambdaExecutionTimeCmd.PersistentFlags().String("startTime", "", "Start time")
 	LambdaExecutionTimeCmd.PersistentFlags().String("endTime", "", "End time")
 	LambdaExecutionTimeCmd.PersistentFlags().String("responseType", "", "Response type. json/frame")
+	LambdaExecutionTimeCmd.PersistentFlags().String("stat", "", "Comma separated statistics, e.g. p50,p90,p99,TM90,IQM")
 }
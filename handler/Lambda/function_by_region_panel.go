@@ -5,10 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/Appkube-awsx/awsx-common/authenticate"
 	"github.com/Appkube-awsx/awsx-common/awsclient"
 	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/spf13/cobra"
 )
@@ -30,7 +35,7 @@ var AwsxLambdaFunctionsByRegionCmd = &cobra.Command{
 		}
 		if authFlag {
 			responseType, _ := cmd.PersistentFlags().GetString("responseType")
-			jsonResp, functionCounts, err := GetLambdaFunctionsByRegion(clientAuth)
+			jsonResp, functionCounts, err := GetLambdaFunctionsByRegion(cmd, clientAuth)
 			if err != nil {
 				log.Println("Error getting Lambda functions by region: ", err)
 				return
@@ -45,7 +50,18 @@ var AwsxLambdaFunctionsByRegionCmd = &cobra.Command{
 	},
 }
 
-func GetLambdaFunctionsByRegion(clientAuth *model.Auth) (string, map[string]interface{}, error) {
+// maxRegionWorkers bounds the number of regions enumerated at the same time
+const maxRegionWorkers = 5
+
+type RegionFunctionSummary struct {
+	Region          string         `json:"region"`
+	FunctionCount   int            `json:"functionCount"`
+	Runtimes        map[string]int `json:"runtimes"`
+	MemorySizes     map[string]int `json:"memorySizes"`
+	LastModifiedAge map[string]int `json:"lastModifiedAge"`
+}
+
+func GetLambdaFunctionsByRegion(cmd *cobra.Command, clientAuth *model.Auth) (string, map[string]interface{}, error) {
     cloudwatchMetricData := make(map[string]interface{})
 
     if clientAuth == nil {
@@ -53,36 +69,68 @@ func GetLambdaFunctionsByRegion(clientAuth *model.Auth) (string, map[string]inte
         return "", nil, errors.New("authentication failed: clientAuth is nil")
     }
 
-    // List of AWS regions
-    regions := []string{"us-east-1", "us-east-2", "us-west-1", "us-west-2", "eu-west-1", "eu-west-2", "ap-northeast-1"}
+    regionsOverride, _ := cmd.PersistentFlags().GetString("regions")
+    regions, err := getLambdaRegions(clientAuth, regionsOverride)
+    if err != nil {
+        log.Println("Error getting regions: ", err)
+        return "", nil, err
+    }
 
-    // Initialize total functions count
-    totalFunctions := 0
+    summaries := make(chan *RegionFunctionSummary, len(regions))
+    sem := make(chan struct{}, maxRegionWorkers)
+    var wg sync.WaitGroup
+
+    // failedRegions holds the error of every region that could not be listed, so that a
+    // short TotalFunctions can be told apart from a complete one
+    failedRegions := map[string]string{}
+    var failedRegionsMu sync.Mutex
 
     for _, region := range regions {
-        newAuth := model.Auth{
-            AccessKey:           clientAuth.AccessKey,
-            SecretKey:           clientAuth.SecretKey,
-            CrossAccountRoleArn: clientAuth.CrossAccountRoleArn,
-            ExternalId:          clientAuth.ExternalId,
-            Region:              region, // Use the region from the list
-        }
-
-        // Get Lambda client for the current region
-        lambdaClient := awsclient.GetClient(newAuth, awsclient.LAMBDA_CLIENT).(*lambda.Lambda)
-
-        // Get total Lambda functions count for the current region
-        count, err := getTotalLambdaFunctions(lambdaClient)
-        if err != nil {
-            log.Printf("Error getting total functions in region %s: %v", region, err)
-            continue
-        }
-        cloudwatchMetricData[region] = count
-        totalFunctions += count
+        wg.Add(1)
+        go func(region string) {
+            defer wg.Done()
+            sem <- struct{}{}
+            defer func() { <-sem }()
+
+            newAuth := model.Auth{
+                AccessKey:           clientAuth.AccessKey,
+                SecretKey:           clientAuth.SecretKey,
+                CrossAccountRoleArn: clientAuth.CrossAccountRoleArn,
+                ExternalId:          clientAuth.ExternalId,
+                Region:              region,
+            }
+
+            // Get Lambda client for the current region
+            lambdaClient := awsclient.GetClient(newAuth, awsclient.LAMBDA_CLIENT).(*lambda.Lambda)
+
+            summary, err := getRegionFunctionSummary(lambdaClient, region)
+            if err != nil {
+                log.Printf("Error getting functions in region %s: %v", region, err)
+                failedRegionsMu.Lock()
+                failedRegions[region] = err.Error()
+                failedRegionsMu.Unlock()
+                return
+            }
+            summaries <- summary
+        }(region)
+    }
+    wg.Wait()
+    close(summaries)
+
+    if len(regions) > 0 && len(failedRegions) == len(regions) {
+        return "", nil, fmt.Errorf("listing functions failed in all %d regions", len(regions))
+    }
+
+    // Initialize total functions count
+    totalFunctions := 0
+    for summary := range summaries {
+        cloudwatchMetricData[summary.Region] = summary
+        totalFunctions += summary.FunctionCount
     }
 
     // Add total functions count to the map
     cloudwatchMetricData["TotalFunctions"] = totalFunctions
+    cloudwatchMetricData["FailedRegions"] = failedRegions
 
     // Marshal the function counts map to JSON string
     jsonString, err := json.Marshal(cloudwatchMetricData)
@@ -97,28 +145,80 @@ func GetLambdaFunctionsByRegion(clientAuth *model.Auth) (string, map[string]inte
     return jsonResp, cloudwatchMetricData, nil
 }
 
-// Function to get total Lambda functions in a region
-func getTotalLambdaFunctions(lambdaClient *lambda.Lambda) (int, error) {
-	totalFunctions := 0
-
-	input := &lambda.ListFunctionsInput{}
-
-	for {
-		resp, err := lambdaClient.ListFunctions(input)
-		if err != nil {
-			return 0, err
+// getLambdaRegions returns the comma separated override when given, otherwise the
+// regions enabled for the account.
+func getLambdaRegions(clientAuth *model.Auth, regionsOverride string) ([]string, error) {
+	var regions []string
+	if regionsOverride != "" {
+		for _, region := range strings.Split(regionsOverride, ",") {
+			if region = strings.TrimSpace(region); region != "" {
+				regions = append(regions, region)
+			}
 		}
+		return regions, nil
+	}
+
+	ec2Client := awsclient.GetClient(*clientAuth, awsclient.EC2_CLIENT).(*ec2.EC2)
+	resp, err := ec2Client.DescribeRegions(&ec2.DescribeRegionsInput{AllRegions: aws.Bool(false)})
+	if err != nil {
+		return nil, err
+	}
+	for _, region := range resp.Regions {
+		regions = append(regions, aws.StringValue(region.RegionName))
+	}
+	return regions, nil
+}
 
-		totalFunctions += len(resp.Functions)
+// Function to get the Lambda functions summary of a region
+func getRegionFunctionSummary(lambdaClient *lambda.Lambda, region string) (*RegionFunctionSummary, error) {
+	summary := &RegionFunctionSummary{
+		Region:          region,
+		Runtimes:        map[string]int{},
+		MemorySizes:     map[string]int{},
+		LastModifiedAge: map[string]int{},
+	}
 
-		if resp.NextMarker != nil {
-			input.Marker = resp.NextMarker
-		} else {
-			break
+	input := &lambda.ListFunctionsInput{}
+	err := lambdaClient.ListFunctionsPages(input, func(page *lambda.ListFunctionsOutput, lastPage bool) bool {
+		for _, function := range page.Functions {
+			summary.FunctionCount++
+
+			runtime := aws.StringValue(function.Runtime)
+			if runtime == "" {
+				// Container image functions have no runtime
+				runtime = aws.StringValue(function.PackageType)
+			}
+			summary.Runtimes[runtime]++
+			summary.MemorySizes[fmt.Sprintf("%dMB", aws.Int64Value(function.MemorySize))]++
+			summary.LastModifiedAge[lastModifiedAgeBucket(aws.StringValue(function.LastModified))]++
 		}
+		return true
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return totalFunctions, nil
+	return summary, nil
+}
+
+func lastModifiedAgeBucket(lastModified string) string {
+	modified, err := time.Parse("2006-01-02T15:04:05.000-0700", lastModified)
+	if err != nil {
+		return "unknown"
+	}
+	age := time.Since(modified)
+	switch {
+	case age < 7*24*time.Hour:
+		return "<7d"
+	case age < 30*24*time.Hour:
+		return "7-30d"
+	case age < 90*24*time.Hour:
+		return "30-90d"
+	case age < 365*24*time.Hour:
+		return "90-365d"
+	default:
+		return ">365d"
+	}
 }
 
 
@@ -139,4 +239,5 @@ func init() {
 	AwsxLambdaFunctionsByRegionCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxLambdaFunctionsByRegionCmd.PersistentFlags().String("endTime", "", "end time")
 	AwsxLambdaFunctionsByRegionCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+	AwsxLambdaFunctionsByRegionCmd.PersistentFlags().String("regions", "", "comma separated regions. defaults to the regions enabled for the account")
 }
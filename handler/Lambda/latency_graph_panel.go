@@ -7,11 +7,11 @@ import (
 	"time"
 
 	"github.com/Appkube-awsx/awsx-common/authenticate"
-	"github.com/Appkube-awsx/awsx-common/awsclient"
 	"github.com/Appkube-awsx/awsx-common/cmdb"
 	"github.com/Appkube-awsx/awsx-common/config"
 	"github.com/Appkube-awsx/awsx-common/model"
-	"github.com/aws/aws-sdk-go/aws"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Statistic"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/spf13/cobra"
 )
@@ -80,6 +80,11 @@ func GetLambdaLatencyGraphData(cmd *cobra.Command, clientAuth *model.Auth, cloud
 	startTimeStr, _ := cmd.PersistentFlags().GetString("startTime")
 	endTimeStr, _ := cmd.PersistentFlags().GetString("endTime")
 
+	statistics, err := Statistic.GetStatistics(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
 	var startTime, endTime *time.Time
 
 	if startTimeStr != "" {
@@ -108,17 +113,35 @@ func GetLambdaLatencyGraphData(cmd *cobra.Command, clientAuth *model.Auth, cloud
 
 	log.Printf("StartTime: %v, EndTime: %v", startTime, endTime)
 
-	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
+	if statistics != nil {
+		latency, cloudwatchMetricData, err := GetLambdaLatencyCountMetricValue(clientAuth, instanceId, elementType, startTime, endTime, statistics, cloudWatchClient)
+		if err != nil {
+			log.Println("Error in getting lambda latency count data: ", err)
+			return "", nil, err
+		}
+		results := map[string]LatencyGraph{}
+		for statistic, points := range latency {
+			results[statistic] = ProcessLambdaLatencyRawData(points)
+		}
+		jsonString, err := json.Marshal(results)
+		if err != nil {
+			log.Println("Error in marshalling json in string: ", err)
+			return "", nil, err
+		}
+		return string(jsonString), cloudwatchMetricData, nil
+	}
 
 	// Fetch raw data
-	LatencyCount, err := GetLambdaLatencyCountMetricValue(clientAuth, instanceId, elementType, startTime, endTime, "Average", cloudWatchClient)
+	LatencyCount, metricData, err := GetLambdaLatencyCountMetricValue(clientAuth, instanceId, elementType, startTime, endTime, []string{"Average"}, cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting lambda latency count data: ", err)
 		return "", nil, err
 	}
-	cloudwatchMetricData["Latency"] = LatencyCount
+	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{
+		"Latency": metricData["Average"],
+	}
 
-	result := ProcessLambdaLatencyRawData(LatencyCount)
+	result := ProcessLambdaLatencyRawData(LatencyCount["Average"])
 
 	jsonString, err := json.Marshal(result)
 	if err != nil {
@@ -130,47 +153,26 @@ func GetLambdaLatencyGraphData(cmd *cobra.Command, clientAuth *model.Auth, cloud
 	return string(jsonString), cloudwatchMetricData, nil
 }
 
-func GetLambdaLatencyCountMetricValue(clientAuth *model.Auth, instanceId string, elementType string, startTime, endTime *time.Time, statistic string, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
-	input := &cloudwatch.GetMetricDataInput{
-		MetricDataQueries: []*cloudwatch.MetricDataQuery{
-			{
-				Id: aws.String("latency"),
-				MetricStat: &cloudwatch.MetricStat{
-					Metric: &cloudwatch.Metric{
-
-						Namespace:  aws.String("AWS/Lambda"),
-						MetricName: aws.String("Duration"),
-					},
-					Period: aws.Int64(300),
-					Stat:   aws.String(statistic),
-				},
-			},
-		},
-		StartTime: startTime,
-		EndTime:   endTime,
-	}
-
-	if cloudWatchClient == nil {
-		cloudWatchClient = awsclient.GetClient(*clientAuth, awsclient.CLOUDWATCH).(*cloudwatch.CloudWatch)
-	}
-
-	result, err := cloudWatchClient.GetMetricData(input)
-	if err != nil {
-		return nil, err
+// GetLambdaLatencyCountMetricValue returns Duration for every statistic, keyed by
+// statistic, in a single request.
+func GetLambdaLatencyCountMetricValue(clientAuth *model.Auth, instanceId string, elementType string, startTime, endTime *time.Time, statistics []string, cloudWatchClient *cloudwatch.CloudWatch) (map[string][]Metric.DataPoint, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	var series []Metric.Series
+	for _, statistic := range statistics {
+		series = append(series, Metric.Series{Label: statistic, MetricName: "Duration", Statistic: statistic})
 	}
-	return result, nil
+	return Metric.GetMetricData(clientAuth, "AWS/Lambda", 300, nil, series, startTime, endTime, cloudWatchClient)
 }
 
-func ProcessLambdaLatencyRawData(result *cloudwatch.GetMetricDataOutput) LatencyGraph {
+func ProcessLambdaLatencyRawData(points []Metric.DataPoint) LatencyGraph {
 	var rawData LatencyGraph
 	rawData.RawData = make([]struct {
 		Timestamp time.Time
 		Value     float64
-	}, len(result.MetricDataResults[0].Timestamps))
+	}, len(points))
 
-	for i, timestamp := range result.MetricDataResults[0].Timestamps {
-		rawData.RawData[i].Timestamp = *timestamp
-		rawData.RawData[i].Value = *result.MetricDataResults[0].Values[i]
+	for i, point := range points {
+		rawData.RawData[i].Timestamp = point.Timestamp
+		rawData.RawData[i].Value = point.Value
 	}
 	return rawData
 }
@@ -193,4 +195,5 @@ func init() {
 	AwsxLambdaLatencyGraphCmd.PersistentFlags().String("endTime", "", "end time")
 	AwsxLambdaLatencyGraphCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
 	AwsxLambdaLatencyGraphCmd.PersistentFlags().String("ApiName", "", "api name")
+	AwsxLambdaLatencyGraphCmd.PersistentFlags().String("stat", "", "comma separated statistics, e.g. p50,p90,p99,TM90,IQM")
 }
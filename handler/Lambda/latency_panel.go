@@ -9,6 +9,8 @@ import (
     "github.com/Appkube-awsx/awsx-common/authenticate"
     "github.com/Appkube-awsx/awsx-common/awsclient"
     "github.com/Appkube-awsx/awsx-common/model"
+    "github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+    "github.com/Appkube-awsx/awsx-getelementdetails/handler/Statistic"
     "github.com/aws/aws-sdk-go/aws"
     "github.com/aws/aws-sdk-go/service/cloudwatch"
     "github.com/spf13/cobra"
@@ -55,6 +57,11 @@ func GetLambdaLatencyData(cmd *cobra.Command, clientAuth *model.Auth, cloudWatch
     startTimeStr, _ := cmd.PersistentFlags().GetString("startTime")
     endTimeStr, _ := cmd.PersistentFlags().GetString("endTime")
 
+    statistics, err := Statistic.GetStatistics(cmd)
+    if err != nil {
+        return "", nil, err
+    }
+
     var startTime, endTime *time.Time
 
     if startTimeStr != "" {
@@ -86,6 +93,25 @@ func GetLambdaLatencyData(cmd *cobra.Command, clientAuth *model.Auth, cloudWatch
 
     cloudwatchMetricData := map[string]float64{}
 
+    if statistics != nil {
+        latencyValues, err := GetLambdaLatencyStatisticValues(clientAuth, startTime, endTime, statistics, cloudWatchClient)
+        if err != nil {
+            log.Println("Error in getting latency value: ", err)
+            return "", nil, err
+        }
+        results := map[string]LatencyResult{}
+        for statistic, latencyValue := range latencyValues {
+            cloudwatchMetricData[statistic] = latencyValue
+            results[statistic] = LatencyResult{Value: latencyValue}
+        }
+        jsonString, err := json.Marshal(results)
+        if err != nil {
+            log.Println("Error in marshalling json in string: ", err)
+            return "", nil, err
+        }
+        return string(jsonString), cloudwatchMetricData, nil
+    }
+
     // Fetch raw data
     avgLatencyValue, err := GetAverageLambdaLatencyMetricValue(clientAuth, startTime, endTime, cloudWatchClient)
     if err != nil {
@@ -135,6 +161,36 @@ func GetAverageLambdaLatencyMetricValue(clientAuth *model.Auth, startTime, endTi
     return averageLatencyValue, nil
 }
 
+// GetLambdaLatencyStatisticValues returns the Duration statistics over the whole time
+// range, keyed by statistic, in a single request. GetMetricStatistics only accepts
+// percentiles as extended statistics, so trimmed means and IQM go through GetMetricData.
+func GetLambdaLatencyStatisticValues(clientAuth *model.Auth, startTime, endTime *time.Time, statistics []string, cloudWatchClient *cloudwatch.CloudWatch) (map[string]float64, error) {
+    // a single period covering the range, rounded up to a multiple of 60 seconds
+    period := int64(endTime.Sub(*startTime).Seconds())
+    period = (period + 59) / 60 * 60
+    if period < 60 {
+        period = 60
+    }
+
+    var series []Metric.Series
+    for _, statistic := range statistics {
+        series = append(series, Metric.Series{Label: statistic, MetricName: "Duration", Statistic: statistic})
+    }
+    result, _, err := Metric.GetMetricData(clientAuth, "AWS/Lambda", period, nil, series, startTime, endTime, cloudWatchClient)
+    if err != nil {
+        return nil, err
+    }
+
+    values := map[string]float64{}
+    for _, statistic := range statistics {
+        if len(result[statistic]) == 0 {
+            return nil, fmt.Errorf("no data available for the specified time range")
+        }
+        values[statistic] = result[statistic][0].Value
+    }
+    return values, nil
+}
+
 func init() {
     AwsxLambdaLatencyCmd.PersistentFlags().String("elementId", "", "element id")
     AwsxLambdaLatencyCmd.PersistentFlags().String("elementType", "", "element type")
@@ -152,4 +208,5 @@ func init() {
     AwsxLambdaLatencyCmd.PersistentFlags().String("startTime", "", "start time")
     AwsxLambdaLatencyCmd.PersistentFlags().String("endTime", "", "end time")
     AwsxLambdaLatencyCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+    AwsxLambdaLatencyCmd.PersistentFlags().String("stat", "", "comma separated statistics, e.g. p50,p90,p99,TM90,IQM")
 }
@@ -0,0 +1,75 @@
+package MSK
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+// every broker reports to the cluster level metric, so the sum is the number of brokers
+// that think they are the controller
+var activeControllerSeries = []Metric.Series{
+	{Label: "ActiveControllerCount", MetricName: "ActiveControllerCount", Statistic: "Sum"},
+}
+
+var AwsxMSKActiveControllerCmd = &cobra.Command{
+	Use:   "msk_active_controller_panel",
+	Short: "get active controllers of the cluster",
+	Long:  `command to get the number of active controllers of the cluster, which should always be one`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetMSKActiveControllerPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting msk active controller count: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetMSKActiveControllerPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetMSKClusterPanel(cmd, clientAuth, activeControllerSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxMSKActiveControllerCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxMSKActiveControllerCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxMSKActiveControllerCmd.PersistentFlags().String("query", "", "query")
+	AwsxMSKActiveControllerCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxMSKActiveControllerCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxMSKActiveControllerCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxMSKActiveControllerCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxMSKActiveControllerCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxMSKActiveControllerCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxMSKActiveControllerCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxMSKActiveControllerCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxMSKActiveControllerCmd.PersistentFlags().String("clusterName", "", "msk cluster name or arn")
+	AwsxMSKActiveControllerCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxMSKActiveControllerCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxMSKActiveControllerCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxMSKActiveControllerCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
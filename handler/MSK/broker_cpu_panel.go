@@ -0,0 +1,92 @@
+package MSK
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var brokerCpuSeries = []Metric.Series{
+	{Label: "CpuUser", MetricName: "CpuUser", Statistic: "Average"},
+	{Label: "CpuSystem", MetricName: "CpuSystem", Statistic: "Average"},
+}
+
+var AwsxMSKBrokerCpuCmd = &cobra.Command{
+	Use:   "msk_broker_cpu_panel",
+	Short: "get cpu usage of the brokers",
+	Long:  `command to get the user, system and total cpu usage of every broker of the cluster`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetMSKBrokerCpuPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting msk broker cpu: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetMSKBrokerCpuPanel returns the user and system cpu of every broker with their sum as
+// CpuTotal, which is what the broker is sized against.
+func GetMSKBrokerCpuPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	result, cloudwatchMetricData, _, err := getBrokerMetricData(cmd, clientAuth, brokerCpuSeries, cloudWatchClient)
+	if err != nil {
+		return "", nil, err
+	}
+
+	for _, brokerResult := range result {
+		brokerResult["CpuTotal"] = Metric.SumSeries(brokerResult["CpuUser"], brokerResult["CpuSystem"])
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func init() {
+	AwsxMSKBrokerCpuCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxMSKBrokerCpuCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxMSKBrokerCpuCmd.PersistentFlags().String("query", "", "query")
+	AwsxMSKBrokerCpuCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxMSKBrokerCpuCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxMSKBrokerCpuCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxMSKBrokerCpuCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxMSKBrokerCpuCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxMSKBrokerCpuCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxMSKBrokerCpuCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxMSKBrokerCpuCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxMSKBrokerCpuCmd.PersistentFlags().String("clusterName", "", "msk cluster name or arn")
+	AwsxMSKBrokerCpuCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxMSKBrokerCpuCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxMSKBrokerCpuCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxMSKBrokerCpuCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,149 @@
+package MSK
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+// networkBaselineGbps is the baseline network bandwidth of the broker instance types. Brokers
+// can burst above it for a while, but sustained traffic is capped there.
+var networkBaselineGbps = map[string]float64{
+	"t3.small":     0.128,
+	"m5.large":     0.75,
+	"m5.xlarge":    1.25,
+	"m5.2xlarge":   2.5,
+	"m5.4xlarge":   5,
+	"m5.8xlarge":   10,
+	"m5.12xlarge":  12,
+	"m5.16xlarge":  20,
+	"m5.24xlarge":  25,
+	"m7g.large":    0.937,
+	"m7g.xlarge":   1.876,
+	"m7g.2xlarge":  3.75,
+	"m7g.4xlarge":  7.5,
+	"m7g.8xlarge":  15,
+	"m7g.12xlarge": 22.5,
+	"m7g.16xlarge": 30,
+}
+
+// BrokerNetwork is the network throughput of a broker against the limit of its instance
+// type. Replication traffic counts towards the limit as well as client traffic.
+type BrokerNetwork struct {
+	InstanceType string `json:"instanceType"`
+	// NetworkLimit is the baseline bandwidth in bytes per second. It is 0 for instance types
+	// not in networkBaselineGbps.
+	NetworkLimit float64                       `json:"networkLimit"`
+	Throughput   map[string][]Metric.DataPoint `json:"throughput"`
+	// PeakUtilization is the highest total throughput as a percent of NetworkLimit.
+	PeakUtilization float64 `json:"peakUtilization"`
+}
+
+var brokerNetworkSeries = []Metric.Series{
+	{Label: "BytesInPerSec", MetricName: "BytesInPerSec", Statistic: "Average"},
+	{Label: "BytesOutPerSec", MetricName: "BytesOutPerSec", Statistic: "Average"},
+	{Label: "ReplicationBytesInPerSec", MetricName: "ReplicationBytesInPerSec", Statistic: "Average"},
+	{Label: "ReplicationBytesOutPerSec", MetricName: "ReplicationBytesOutPerSec", Statistic: "Average"},
+}
+
+var AwsxMSKBrokerNetworkCmd = &cobra.Command{
+	Use:   "msk_broker_network_panel",
+	Short: "get network throughput of the brokers against their limit",
+	Long:  `command to get the network throughput of every broker of the cluster against the baseline bandwidth of its instance type`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetMSKBrokerNetworkPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting msk broker network: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetMSKBrokerNetworkPanel returns the client and replication throughput of every broker,
+// their total and how close the total came to the baseline bandwidth of the broker.
+func GetMSKBrokerNetworkPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	result, cloudwatchMetricData, brokers, err := getBrokerMetricData(cmd, clientAuth, brokerNetworkSeries, cloudWatchClient)
+	if err != nil {
+		return "", nil, err
+	}
+
+	brokerNetwork := map[string]BrokerNetwork{}
+	for _, broker := range brokers {
+		throughput := result[broker.BrokerId]
+		var series [][]Metric.DataPoint
+		for _, s := range brokerNetworkSeries {
+			series = append(series, throughput[s.Label])
+		}
+		throughput["BytesTotalPerSec"] = Metric.SumSeries(series...)
+
+		network := BrokerNetwork{
+			InstanceType: broker.InstanceType,
+			NetworkLimit: networkBaselineGbps[broker.InstanceType] * 1e9 / 8,
+			Throughput:   throughput,
+		}
+		if network.NetworkLimit > 0 {
+			for _, point := range throughput["BytesTotalPerSec"] {
+				if utilization := point.Value / network.NetworkLimit * 100; utilization > network.PeakUtilization {
+					network.PeakUtilization = utilization
+				}
+			}
+		} else {
+			log.Printf("No network limit known for instance type %s of broker %s", broker.InstanceType, broker.BrokerId)
+		}
+		brokerNetwork[broker.BrokerId] = network
+	}
+
+	jsonString, err := json.Marshal(brokerNetwork)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func init() {
+	AwsxMSKBrokerNetworkCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxMSKBrokerNetworkCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxMSKBrokerNetworkCmd.PersistentFlags().String("query", "", "query")
+	AwsxMSKBrokerNetworkCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxMSKBrokerNetworkCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxMSKBrokerNetworkCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxMSKBrokerNetworkCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxMSKBrokerNetworkCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxMSKBrokerNetworkCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxMSKBrokerNetworkCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxMSKBrokerNetworkCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxMSKBrokerNetworkCmd.PersistentFlags().String("clusterName", "", "msk cluster name or arn")
+	AwsxMSKBrokerNetworkCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxMSKBrokerNetworkCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxMSKBrokerNetworkCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxMSKBrokerNetworkCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
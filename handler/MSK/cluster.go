@@ -0,0 +1,137 @@
+package MSK
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/cmdb"
+	"github.com/Appkube-awsx/awsx-common/config"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/spf13/cobra"
+)
+
+// Broker is a broker of the cluster. BrokerId is the "Broker ID" dimension value.
+type Broker struct {
+	BrokerId     string
+	InstanceType string
+}
+
+// GetMSKClusterName returns the cluster from the clusterName flag, the CMDB element or the
+// instanceId flag, in that order. A cluster ARN is accepted in place of the name.
+func GetMSKClusterName(cmd *cobra.Command) (string, error) {
+	clusterName, _ := cmd.PersistentFlags().GetString("clusterName")
+	elementId, _ := cmd.PersistentFlags().GetString("elementId")
+	cmdbApiUrl, _ := cmd.PersistentFlags().GetString("cmdbApiUrl")
+	instanceId, _ := cmd.PersistentFlags().GetString("instanceId")
+
+	if clusterName != "" {
+		return clusterName, nil
+	}
+
+	if elementId != "" {
+		log.Println("getting cloud-element data from cmdb")
+		apiUrl := cmdbApiUrl
+		if cmdbApiUrl == "" {
+			log.Println("using default cmdb url")
+			apiUrl = config.CmdbUrl
+		}
+		log.Println("cmdb url: " + apiUrl)
+		cmdbData, err := cmdb.GetCloudElementData(apiUrl, elementId)
+		if err != nil {
+			return "", err
+		}
+		instanceId = cmdbData.InstanceId
+		if cmdbData.Arn != "" {
+			instanceId = cmdbData.Arn
+		}
+	}
+
+	if instanceId == "" {
+		return "", errors.New("cluster name not provided. provide clusterName or elementId")
+	}
+	return instanceId, nil
+}
+
+// clusterName returns the "Cluster Name" dimension value of a cluster name or ARN. The ARN
+// ends in cluster/<name>/<uuid>.
+func clusterName(cluster string) string {
+	if !strings.HasPrefix(cluster, "arn:") {
+		return cluster
+	}
+	parts := strings.Split(cluster, "/")
+	if len(parts) < 2 {
+		return cluster
+	}
+	return parts[1]
+}
+
+func getKafkaClient(clientAuth *model.Auth) *kafka.Kafka {
+	// awsclient has no Kafka client type, so the client is built on the assumed role session
+	return kafka.New(awsclient.GetSessionWithAssumeRole(*clientAuth))
+}
+
+// GetClusterArn returns the ARN of the cluster, looking it up by name unless an ARN is given.
+func GetClusterArn(kafkaClient *kafka.Kafka, cluster string) (string, error) {
+	if strings.HasPrefix(cluster, "arn:") {
+		return cluster, nil
+	}
+
+	var clusterArn string
+	input := &kafka.ListClustersV2Input{
+		ClusterNameFilter: aws.String(cluster),
+	}
+	err := kafkaClient.ListClustersV2Pages(input, func(page *kafka.ListClustersV2Output, lastPage bool) bool {
+		for _, c := range page.ClusterInfoList {
+			if aws.StringValue(c.ClusterName) == cluster {
+				clusterArn = aws.StringValue(c.ClusterArn)
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return "", err
+	}
+	if clusterArn == "" {
+		return "", fmt.Errorf("cluster %s not found", cluster)
+	}
+	return clusterArn, nil
+}
+
+// GetBrokers lists the brokers of the cluster, so panels follow the cluster as brokers are
+// added. The instance type is returned without its "kafka." prefix.
+func GetBrokers(clientAuth *model.Auth, cluster string) ([]Broker, error) {
+	kafkaClient := getKafkaClient(clientAuth)
+
+	clusterArn, err := GetClusterArn(kafkaClient, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	brokers := []Broker{}
+	input := &kafka.ListNodesInput{
+		ClusterArn: aws.String(clusterArn),
+	}
+	err = kafkaClient.ListNodesPages(input, func(page *kafka.ListNodesOutput, lastPage bool) bool {
+		for _, node := range page.NodeInfoList {
+			if node.BrokerNodeInfo == nil {
+				continue
+			}
+			brokers = append(brokers, Broker{
+				BrokerId:     strconv.FormatFloat(aws.Float64Value(node.BrokerNodeInfo.BrokerId), 'f', -1, 64),
+				InstanceType: strings.TrimPrefix(aws.StringValue(node.InstanceType), "kafka."),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return brokers, nil
+}
@@ -0,0 +1,141 @@
+package MSK
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var consumerLagSeries = []Metric.Series{
+	{Label: "EstimatedMaxTimeLag", MetricName: "EstimatedMaxTimeLag", Statistic: "Maximum"},
+	{Label: "SumOffsetLag", MetricName: "SumOffsetLag", Statistic: "Maximum"},
+}
+
+var AwsxMSKConsumerLagCmd = &cobra.Command{
+	Use:   "msk_consumer_lag_panel",
+	Short: "get consumer group lag",
+	Long:  `command to get the estimated time lag and offset lag of every consumer group of the cluster`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetMSKConsumerLagPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting msk consumer lag: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetMSKConsumerLagPanel returns the lag of every consumer group on every topic it reads,
+// keyed by consumer group and then topic. The groups are found with ListMetrics, as MSK
+// only publishes lag for groups that committed offsets.
+func GetMSKConsumerLagPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	cluster, err := GetMSKClusterName(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if cloudWatchClient == nil {
+		cloudWatchClient = awsclient.GetClient(*clientAuth, awsclient.CLOUDWATCH).(*cloudwatch.CloudWatch)
+	}
+
+	metrics, err := Metric.ListMetrics(cloudWatchClient, namespace, "SumOffsetLag", []*cloudwatch.DimensionFilter{
+		{Name: aws.String("Cluster Name"), Value: aws.String(clusterName(cluster))},
+		{Name: aws.String("Consumer Group")},
+		{Name: aws.String("Topic")},
+	})
+	if err != nil {
+		log.Println("Error in listing consumer lag metrics: ", err)
+		return "", nil, err
+	}
+
+	type groupTopic struct {
+		group string
+		topic string
+	}
+	groupTopics := map[string]groupTopic{}
+	entityDimensions := map[string][]*cloudwatch.Dimension{}
+	for _, metric := range metrics {
+		key := groupTopic{
+			group: Metric.DimensionValue(metric.Dimensions, "Consumer Group"),
+			topic: Metric.DimensionValue(metric.Dimensions, "Topic"),
+		}
+		entity := key.group + "/" + key.topic
+		groupTopics[entity] = key
+		entityDimensions[entity] = metric.Dimensions
+	}
+
+	entityResult, cloudwatchMetricData, err := Metric.GetEntityMetricData(clientAuth, namespace, period, entityDimensions, consumerLagSeries, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting consumer lag metric data: ", err)
+		return "", nil, err
+	}
+
+	result := map[string]map[string]map[string][]Metric.DataPoint{}
+	for entity, key := range groupTopics {
+		if _, ok := result[key.group]; !ok {
+			result[key.group] = map[string]map[string][]Metric.DataPoint{}
+		}
+		result[key.group][key.topic] = entityResult[entity]
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func init() {
+	AwsxMSKConsumerLagCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxMSKConsumerLagCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxMSKConsumerLagCmd.PersistentFlags().String("query", "", "query")
+	AwsxMSKConsumerLagCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxMSKConsumerLagCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxMSKConsumerLagCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxMSKConsumerLagCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxMSKConsumerLagCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxMSKConsumerLagCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxMSKConsumerLagCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxMSKConsumerLagCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxMSKConsumerLagCmd.PersistentFlags().String("clusterName", "", "msk cluster name or arn")
+	AwsxMSKConsumerLagCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxMSKConsumerLagCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxMSKConsumerLagCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxMSKConsumerLagCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
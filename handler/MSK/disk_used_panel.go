@@ -0,0 +1,73 @@
+package MSK
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var diskUsedSeries = []Metric.Series{
+	{Label: "KafkaDataLogsDiskUsed", MetricName: "KafkaDataLogsDiskUsed", Statistic: "Maximum"},
+}
+
+var AwsxMSKDiskUsedCmd = &cobra.Command{
+	Use:   "msk_disk_used_panel",
+	Short: "get data log disk usage of the brokers",
+	Long:  `command to get the percent of disk used for data logs on every broker of the cluster`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetMSKDiskUsedPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting msk disk used: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetMSKDiskUsedPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetMSKBrokerPanel(cmd, clientAuth, diskUsedSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxMSKDiskUsedCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxMSKDiskUsedCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxMSKDiskUsedCmd.PersistentFlags().String("query", "", "query")
+	AwsxMSKDiskUsedCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxMSKDiskUsedCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxMSKDiskUsedCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxMSKDiskUsedCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxMSKDiskUsedCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxMSKDiskUsedCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxMSKDiskUsedCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxMSKDiskUsedCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxMSKDiskUsedCmd.PersistentFlags().String("clusterName", "", "msk cluster name or arn")
+	AwsxMSKDiskUsedCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxMSKDiskUsedCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxMSKDiskUsedCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxMSKDiskUsedCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,99 @@
+package MSK
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+const namespace = "AWS/Kafka"
+
+// period is the granularity MSK publishes its metrics at
+const period = 60
+
+// GetMSKClusterPanel resolves the cluster and returns the given cluster level series of it.
+func GetMSKClusterPanel(cmd *cobra.Command, clientAuth *model.Auth, series []Metric.Series, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	cluster, err := GetMSKClusterName(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result, cloudwatchMetricData, err := Metric.GetMetricData(clientAuth, namespace, period, clusterDimensions(clusterName(cluster)), series, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting cluster metric data: ", err)
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+// GetMSKBrokerPanel resolves the cluster into its brokers and returns the given series of
+// every broker, keyed by broker id.
+func GetMSKBrokerPanel(cmd *cobra.Command, clientAuth *model.Auth, series []Metric.Series, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	result, cloudwatchMetricData, _, err := getBrokerMetricData(cmd, clientAuth, series, cloudWatchClient)
+	if err != nil {
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func getBrokerMetricData(cmd *cobra.Command, clientAuth *model.Auth, series []Metric.Series, cloudWatchClient *cloudwatch.CloudWatch) (map[string]map[string][]Metric.DataPoint, map[string]*cloudwatch.GetMetricDataOutput, []Broker, error) {
+	cluster, err := GetMSKClusterName(cmd)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	brokers, err := GetBrokers(clientAuth, cluster)
+	if err != nil {
+		log.Println("Error in getting brokers: ", err)
+		return nil, nil, nil, err
+	}
+
+	brokerDimensions := map[string][]*cloudwatch.Dimension{}
+	for _, broker := range brokers {
+		brokerDimensions[broker.BrokerId] = append(clusterDimensions(clusterName(cluster)), &cloudwatch.Dimension{
+			Name:  aws.String("Broker ID"),
+			Value: aws.String(broker.BrokerId),
+		})
+	}
+
+	result, cloudwatchMetricData, err := Metric.GetEntityMetricData(clientAuth, namespace, period, brokerDimensions, series, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting broker metric data: ", err)
+		return nil, nil, nil, err
+	}
+	return result, cloudwatchMetricData, brokers, nil
+}
+
+func clusterDimensions(clusterName string) []*cloudwatch.Dimension {
+	return Metric.Dimensions("Cluster Name", clusterName)
+}
@@ -0,0 +1,73 @@
+package MSK
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var offlinePartitionsSeries = []Metric.Series{
+	{Label: "OfflinePartitionsCount", MetricName: "OfflinePartitionsCount", Statistic: "Maximum"},
+}
+
+var AwsxMSKOfflinePartitionsCmd = &cobra.Command{
+	Use:   "msk_offline_partitions_panel",
+	Short: "get offline partitions of the cluster",
+	Long:  `command to get the number of partitions of the cluster that are offline`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetMSKOfflinePartitionsPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting msk offline partitions: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetMSKOfflinePartitionsPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetMSKClusterPanel(cmd, clientAuth, offlinePartitionsSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxMSKOfflinePartitionsCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxMSKOfflinePartitionsCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxMSKOfflinePartitionsCmd.PersistentFlags().String("query", "", "query")
+	AwsxMSKOfflinePartitionsCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxMSKOfflinePartitionsCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxMSKOfflinePartitionsCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxMSKOfflinePartitionsCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxMSKOfflinePartitionsCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxMSKOfflinePartitionsCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxMSKOfflinePartitionsCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxMSKOfflinePartitionsCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxMSKOfflinePartitionsCmd.PersistentFlags().String("clusterName", "", "msk cluster name or arn")
+	AwsxMSKOfflinePartitionsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxMSKOfflinePartitionsCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxMSKOfflinePartitionsCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxMSKOfflinePartitionsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
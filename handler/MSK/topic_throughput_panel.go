@@ -0,0 +1,140 @@
+package MSK
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var topicThroughputSeries = []Metric.Series{
+	{Label: "BytesInPerSec", MetricName: "BytesInPerSec", Statistic: "Average"},
+	{Label: "BytesOutPerSec", MetricName: "BytesOutPerSec", Statistic: "Average"},
+}
+
+var AwsxMSKTopicThroughputCmd = &cobra.Command{
+	Use:   "msk_topic_throughput_panel",
+	Short: "get bytes in and out per topic",
+	Long:  `command to get the bytes in and out per second of every topic of the cluster`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetMSKTopicThroughputPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting msk topic throughput: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetMSKTopicThroughputPanel returns the bytes in and out per second of every topic, keyed
+// by topic. Topic metrics are only published per broker with the PER_TOPIC_PER_BROKER
+// monitoring level, so the topics are found with ListMetrics and summed over the brokers.
+func GetMSKTopicThroughputPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	cluster, err := GetMSKClusterName(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if cloudWatchClient == nil {
+		cloudWatchClient = awsclient.GetClient(*clientAuth, awsclient.CLOUDWATCH).(*cloudwatch.CloudWatch)
+	}
+
+	metrics, err := Metric.ListMetrics(cloudWatchClient, namespace, "BytesInPerSec", []*cloudwatch.DimensionFilter{
+		{Name: aws.String("Cluster Name"), Value: aws.String(clusterName(cluster))},
+		{Name: aws.String("Broker ID")},
+		{Name: aws.String("Topic")},
+	})
+	if err != nil {
+		log.Println("Error in listing topic metrics: ", err)
+		return "", nil, err
+	}
+
+	// the entities are topic and broker pairs, keyed by their position in metrics
+	topics := map[string]string{}
+	entityDimensions := map[string][]*cloudwatch.Dimension{}
+	for i, metric := range metrics {
+		entity := strconv.Itoa(i)
+		topics[entity] = Metric.DimensionValue(metric.Dimensions, "Topic")
+		entityDimensions[entity] = metric.Dimensions
+	}
+
+	entityResult, entityMetricData, err := Metric.GetEntityMetricData(clientAuth, namespace, period, entityDimensions, topicThroughputSeries, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting topic metric data: ", err)
+		return "", nil, err
+	}
+
+	result := map[string]map[string][]Metric.DataPoint{}
+	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
+	for entity, topic := range topics {
+		if _, ok := result[topic]; !ok {
+			result[topic] = map[string][]Metric.DataPoint{}
+			cloudwatchMetricData[topic] = &cloudwatch.GetMetricDataOutput{}
+		}
+		for _, s := range topicThroughputSeries {
+			result[topic][s.Label] = Metric.SumSeries(result[topic][s.Label], entityResult[entity][s.Label])
+		}
+		cloudwatchMetricData[topic].MetricDataResults = append(cloudwatchMetricData[topic].MetricDataResults, entityMetricData[entity].MetricDataResults...)
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func init() {
+	AwsxMSKTopicThroughputCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxMSKTopicThroughputCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxMSKTopicThroughputCmd.PersistentFlags().String("query", "", "query")
+	AwsxMSKTopicThroughputCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxMSKTopicThroughputCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxMSKTopicThroughputCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxMSKTopicThroughputCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxMSKTopicThroughputCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxMSKTopicThroughputCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxMSKTopicThroughputCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxMSKTopicThroughputCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxMSKTopicThroughputCmd.PersistentFlags().String("clusterName", "", "msk cluster name or arn")
+	AwsxMSKTopicThroughputCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxMSKTopicThroughputCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxMSKTopicThroughputCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxMSKTopicThroughputCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
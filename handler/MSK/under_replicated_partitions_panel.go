@@ -0,0 +1,73 @@
+package MSK
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var underReplicatedPartitionsSeries = []Metric.Series{
+	{Label: "UnderReplicatedPartitions", MetricName: "UnderReplicatedPartitions", Statistic: "Maximum"},
+}
+
+var AwsxMSKUnderReplicatedPartitionsCmd = &cobra.Command{
+	Use:   "msk_under_replicated_partitions_panel",
+	Short: "get under replicated partitions of the brokers",
+	Long:  `command to get the number of under replicated partitions on every broker of the cluster`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetMSKUnderReplicatedPartitionsPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting msk under replicated partitions: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetMSKUnderReplicatedPartitionsPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetMSKBrokerPanel(cmd, clientAuth, underReplicatedPartitionsSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxMSKUnderReplicatedPartitionsCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxMSKUnderReplicatedPartitionsCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxMSKUnderReplicatedPartitionsCmd.PersistentFlags().String("query", "", "query")
+	AwsxMSKUnderReplicatedPartitionsCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxMSKUnderReplicatedPartitionsCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxMSKUnderReplicatedPartitionsCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxMSKUnderReplicatedPartitionsCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxMSKUnderReplicatedPartitionsCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxMSKUnderReplicatedPartitionsCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxMSKUnderReplicatedPartitionsCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxMSKUnderReplicatedPartitionsCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxMSKUnderReplicatedPartitionsCmd.PersistentFlags().String("clusterName", "", "msk cluster name or arn")
+	AwsxMSKUnderReplicatedPartitionsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxMSKUnderReplicatedPartitionsCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxMSKUnderReplicatedPartitionsCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxMSKUnderReplicatedPartitionsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
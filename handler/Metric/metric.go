@@ -0,0 +1,242 @@
+package Metric
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+// maxQueries is the GetMetricData limit on metric data queries per request
+const maxQueries = 500
+
+type DataPoint struct {
+	Timestamp time.Time
+	Value     float64
+}
+
+type MetricStat struct {
+	MetricName string
+	Statistic  string
+}
+
+// Series is one series of a panel, keyed by Label in the output. Without an Expression
+// it reads MetricName with Statistic, or the single metric in Metrics. Otherwise
+// Expression combines the metrics, with {0}, {1}, ... standing for Metrics in order. An
+// Expression without Metrics is sent as is, e.g. a SEARCH. Dimensions overrides the
+// dimensions of the request for metrics published with other dimensions.
+type Series struct {
+	Label      string
+	MetricName string
+	Statistic  string
+	Metrics    []MetricStat
+	Expression string
+	Dimensions []*cloudwatch.Dimension
+}
+
+// GetMetricData queries namespace for every series with the given dimensions. Both
+// results are keyed by series label.
+func GetMetricData(clientAuth *model.Auth, namespace string, period int64, dimensions []*cloudwatch.Dimension, series []Series, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (map[string][]DataPoint, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	result, metricResults, err := getMetricData(clientAuth, namespace, period, map[string][]*cloudwatch.Dimension{"": dimensions}, series, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
+	for label, results := range metricResults[""] {
+		cloudwatchMetricData[label] = &cloudwatch.GetMetricDataOutput{MetricDataResults: results}
+	}
+	return result[""], cloudwatchMetricData, nil
+}
+
+// GetEntityMetricData queries namespace for every series of every entity, given as a map
+// of entity key to its dimensions, in as few requests as GetMetricData allows. Both
+// results are keyed by entity key; the data points further by series label.
+func GetEntityMetricData(clientAuth *model.Auth, namespace string, period int64, entityDimensions map[string][]*cloudwatch.Dimension, series []Series, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (map[string]map[string][]DataPoint, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	result, metricResults, err := getMetricData(clientAuth, namespace, period, entityDimensions, series, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
+	for entity := range entityDimensions {
+		cloudwatchMetricData[entity] = &cloudwatch.GetMetricDataOutput{}
+		for _, s := range series {
+			cloudwatchMetricData[entity].MetricDataResults = append(cloudwatchMetricData[entity].MetricDataResults, metricResults[entity][s.Label]...)
+		}
+	}
+	return result, cloudwatchMetricData, nil
+}
+
+// getMetricData returns the data points and the raw results by entity and series label.
+func getMetricData(clientAuth *model.Auth, namespace string, period int64, entityDimensions map[string][]*cloudwatch.Dimension, series []Series, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (map[string]map[string][]DataPoint, map[string]map[string][]*cloudwatch.MetricDataResult, error) {
+	type queryKey struct {
+		entity string
+		label  string
+	}
+	keys := map[string]queryKey{}
+	// groups holds the queries of each series together, so a batch never separates an
+	// expression from the metrics it reads
+	var groups [][]*cloudwatch.MetricDataQuery
+	result := map[string]map[string][]DataPoint{}
+	metricResults := map[string]map[string][]*cloudwatch.MetricDataResult{}
+	i := 0
+	for entity, dimensions := range entityDimensions {
+		result[entity] = map[string][]DataPoint{}
+		metricResults[entity] = map[string][]*cloudwatch.MetricDataResult{}
+		for _, s := range series {
+			id := "m" + strconv.Itoa(i)
+			i++
+			keys[id] = queryKey{entity: entity, label: s.Label}
+			result[entity][s.Label] = []DataPoint{}
+
+			seriesDimensions := dimensions
+			if s.Dimensions != nil {
+				seriesDimensions = s.Dimensions
+			}
+			groups = append(groups, seriesQueries(id, namespace, period, seriesDimensions, s))
+		}
+	}
+
+	if cloudWatchClient == nil {
+		cloudWatchClient = awsclient.GetClient(*clientAuth, awsclient.CLOUDWATCH).(*cloudwatch.CloudWatch)
+	}
+
+	for len(groups) > 0 {
+		var queries []*cloudwatch.MetricDataQuery
+		for len(groups) > 0 && (queries == nil || len(queries)+len(groups[0]) <= maxQueries) {
+			queries = append(queries, groups[0]...)
+			groups = groups[1:]
+		}
+		input := &cloudwatch.GetMetricDataInput{
+			EndTime:           endTime,
+			StartTime:         startTime,
+			ScanBy:            aws.String(cloudwatch.ScanByTimestampAscending),
+			MetricDataQueries: queries,
+		}
+		err := cloudWatchClient.GetMetricDataPages(input, func(page *cloudwatch.GetMetricDataOutput, lastPage bool) bool {
+			for _, metricResult := range page.MetricDataResults {
+				key, ok := keys[aws.StringValue(metricResult.Id)]
+				if !ok {
+					continue
+				}
+				metricResults[key.entity][key.label] = append(metricResults[key.entity][key.label], metricResult)
+				for i := range metricResult.Timestamps {
+					result[key.entity][key.label] = append(result[key.entity][key.label], DataPoint{
+						Timestamp: *metricResult.Timestamps[i],
+						Value:     *metricResult.Values[i],
+					})
+				}
+			}
+			return true
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return result, metricResults, nil
+}
+
+// seriesQueries builds the queries of one series. The query returning the series has
+// the given id; the metrics an expression reads are not returned themselves.
+func seriesQueries(id, namespace string, period int64, dimensions []*cloudwatch.Dimension, s Series) []*cloudwatch.MetricDataQuery {
+	metrics := s.Metrics
+	if len(metrics) == 0 && s.MetricName != "" {
+		metrics = []MetricStat{{MetricName: s.MetricName, Statistic: s.Statistic}}
+	}
+
+	var queries []*cloudwatch.MetricDataQuery
+	expression := s.Expression
+	for k, metric := range metrics {
+		metricId := id
+		if s.Expression != "" {
+			metricId = id + "m" + strconv.Itoa(k)
+			expression = strings.ReplaceAll(expression, "{"+strconv.Itoa(k)+"}", metricId)
+		}
+		queries = append(queries, &cloudwatch.MetricDataQuery{
+			Id:         aws.String(metricId),
+			Label:      aws.String(s.Label),
+			ReturnData: aws.Bool(s.Expression == ""),
+			MetricStat: &cloudwatch.MetricStat{
+				Metric: &cloudwatch.Metric{
+					Dimensions: dimensions,
+					MetricName: aws.String(metric.MetricName),
+					Namespace:  aws.String(namespace),
+				},
+				Period: aws.Int64(period),
+				Stat:   aws.String(metric.Statistic),
+			},
+		})
+	}
+	if s.Expression != "" {
+		queries = append(queries, &cloudwatch.MetricDataQuery{
+			Id:         aws.String(id),
+			Label:      aws.String(s.Label),
+			Expression: aws.String(expression),
+		})
+	}
+	return queries
+}
+
+// SumSeries adds up the series by timestamp. A timestamp missing from some series counts
+// as zero there.
+func SumSeries(series ...[]DataPoint) []DataPoint {
+	sums := map[time.Time]float64{}
+	for _, points := range series {
+		for _, point := range points {
+			sums[point.Timestamp] += point.Value
+		}
+	}
+
+	result := []DataPoint{}
+	for timestamp, value := range sums {
+		result = append(result, DataPoint{Timestamp: timestamp, Value: value})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Timestamp.Before(result[j].Timestamp)
+	})
+	return result
+}
+
+// ListMetrics returns the metrics of namespace with the given name matching the filters.
+func ListMetrics(cloudWatchClient *cloudwatch.CloudWatch, namespace, metricName string, filters []*cloudwatch.DimensionFilter) ([]*cloudwatch.Metric, error) {
+	var metrics []*cloudwatch.Metric
+	input := &cloudwatch.ListMetricsInput{
+		Namespace:  aws.String(namespace),
+		MetricName: aws.String(metricName),
+		Dimensions: filters,
+	}
+	err := cloudWatchClient.ListMetricsPages(input, func(page *cloudwatch.ListMetricsOutput, lastPage bool) bool {
+		metrics = append(metrics, page.Metrics...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return metrics, nil
+}
+
+func DimensionValue(dimensions []*cloudwatch.Dimension, name string) string {
+	for _, dimension := range dimensions {
+		if aws.StringValue(dimension.Name) == name {
+			return aws.StringValue(dimension.Value)
+		}
+	}
+	return ""
+}
+
+// Dimensions returns a single dimension, the usual way a resource is identified.
+func Dimensions(name, value string) []*cloudwatch.Dimension {
+	return []*cloudwatch.Dimension{
+		{
+			Name:  aws.String(name),
+			Value: aws.String(value),
+		},
+	}
+}
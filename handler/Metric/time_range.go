@@ -0,0 +1,44 @@
+package Metric
+
+import (
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// ParseTimeRange parses the startTime and endTime flags. Without startTime the range
+// starts defaultRange before the end.
+func ParseTimeRange(cmd *cobra.Command, defaultRange time.Duration) (*time.Time, *time.Time, error) {
+	startTimeStr, _ := cmd.PersistentFlags().GetString("startTime")
+	endTimeStr, _ := cmd.PersistentFlags().GetString("endTime")
+
+	var startTime, endTime *time.Time
+
+	if endTimeStr != "" {
+		parsedEndTime, err := time.Parse(time.RFC3339, endTimeStr)
+		if err != nil {
+			log.Printf("Error parsing end time: %v", err)
+			return nil, nil, err
+		}
+		endTime = &parsedEndTime
+	} else {
+		defaultEndTime := time.Now()
+		endTime = &defaultEndTime
+	}
+
+	if startTimeStr != "" {
+		parsedStartTime, err := time.Parse(time.RFC3339, startTimeStr)
+		if err != nil {
+			log.Printf("Error parsing start time: %v", err)
+			return nil, nil, err
+		}
+		startTime = &parsedStartTime
+	} else {
+		defaultStartTime := endTime.Add(-defaultRange)
+		startTime = &defaultStartTime
+	}
+
+	log.Printf("StartTime: %v, EndTime: %v", startTime, endTime)
+	return startTime, endTime, nil
+}
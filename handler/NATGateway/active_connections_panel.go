@@ -0,0 +1,73 @@
+package NATGateway
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var activeConnectionsSeries = []Metric.Series{
+	{Label: "ActiveConnectionCount", MetricName: "ActiveConnectionCount", Statistic: "Maximum"},
+}
+
+var AwsxNATGatewayActiveConnectionsCmd = &cobra.Command{
+	Use:   "nat_gateway_active_connections_panel",
+	Short: "get active connections of the nat gateway",
+	Long:  `command to get the number of concurrent active tcp connections through the nat gateway`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetNATGatewayActiveConnectionsPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting nat gateway active connections: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetNATGatewayActiveConnectionsPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetNATGatewayPanel(cmd, clientAuth, activeConnectionsSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxNATGatewayActiveConnectionsCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxNATGatewayActiveConnectionsCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxNATGatewayActiveConnectionsCmd.PersistentFlags().String("query", "", "query")
+	AwsxNATGatewayActiveConnectionsCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxNATGatewayActiveConnectionsCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxNATGatewayActiveConnectionsCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxNATGatewayActiveConnectionsCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxNATGatewayActiveConnectionsCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxNATGatewayActiveConnectionsCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxNATGatewayActiveConnectionsCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxNATGatewayActiveConnectionsCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxNATGatewayActiveConnectionsCmd.PersistentFlags().String("NatGatewayId", "", "nat gateway id")
+	AwsxNATGatewayActiveConnectionsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxNATGatewayActiveConnectionsCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxNATGatewayActiveConnectionsCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxNATGatewayActiveConnectionsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
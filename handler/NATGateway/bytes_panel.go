@@ -0,0 +1,76 @@
+package NATGateway
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var bytesSeries = []Metric.Series{
+	{Label: "BytesInFromSource", MetricName: "BytesInFromSource", Statistic: "Sum"},
+	{Label: "BytesOutToDestination", MetricName: "BytesOutToDestination", Statistic: "Sum"},
+	{Label: "BytesInFromDestination", MetricName: "BytesInFromDestination", Statistic: "Sum"},
+	{Label: "BytesOutToSource", MetricName: "BytesOutToSource", Statistic: "Sum"},
+}
+
+var AwsxNATGatewayBytesCmd = &cobra.Command{
+	Use:   "nat_gateway_bytes_panel",
+	Short: "get bytes through the nat gateway",
+	Long:  `command to get the bytes the nat gateway received and sent on the source and destination side`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetNATGatewayBytesPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting nat gateway bytes: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetNATGatewayBytesPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetNATGatewayPanel(cmd, clientAuth, bytesSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxNATGatewayBytesCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxNATGatewayBytesCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxNATGatewayBytesCmd.PersistentFlags().String("query", "", "query")
+	AwsxNATGatewayBytesCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxNATGatewayBytesCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxNATGatewayBytesCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxNATGatewayBytesCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxNATGatewayBytesCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxNATGatewayBytesCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxNATGatewayBytesCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxNATGatewayBytesCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxNATGatewayBytesCmd.PersistentFlags().String("NatGatewayId", "", "nat gateway id")
+	AwsxNATGatewayBytesCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxNATGatewayBytesCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxNATGatewayBytesCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxNATGatewayBytesCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,74 @@
+package NATGateway
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var connectionsSeries = []Metric.Series{
+	{Label: "ConnectionAttemptCount", MetricName: "ConnectionAttemptCount", Statistic: "Sum"},
+	{Label: "ConnectionEstablishedCount", MetricName: "ConnectionEstablishedCount", Statistic: "Sum"},
+}
+
+var AwsxNATGatewayConnectionsCmd = &cobra.Command{
+	Use:   "nat_gateway_connections_panel",
+	Short: "get attempted and established connections of the nat gateway",
+	Long:  `command to get the connection attempts through the nat gateway next to the connections established`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetNATGatewayConnectionsPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting nat gateway connections: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetNATGatewayConnectionsPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetNATGatewayPanel(cmd, clientAuth, connectionsSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxNATGatewayConnectionsCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxNATGatewayConnectionsCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxNATGatewayConnectionsCmd.PersistentFlags().String("query", "", "query")
+	AwsxNATGatewayConnectionsCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxNATGatewayConnectionsCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxNATGatewayConnectionsCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxNATGatewayConnectionsCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxNATGatewayConnectionsCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxNATGatewayConnectionsCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxNATGatewayConnectionsCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxNATGatewayConnectionsCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxNATGatewayConnectionsCmd.PersistentFlags().String("NatGatewayId", "", "nat gateway id")
+	AwsxNATGatewayConnectionsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxNATGatewayConnectionsCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxNATGatewayConnectionsCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxNATGatewayConnectionsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
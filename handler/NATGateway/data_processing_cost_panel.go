@@ -0,0 +1,140 @@
+package NATGateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+// defaultPricePerGB is the us-east-1 data processing price. Other regions charge more, so
+// pass pricePerGB there.
+const defaultPricePerGB = 0.045
+
+// DataProcessingCost is the estimated data processing charge of the NAT gateway over the
+// window. Both directions are billed, so the processed bytes are the bytes received from
+// the source and from the destination.
+type DataProcessingCost struct {
+	StartTime      time.Time `json:"startTime"`
+	EndTime        time.Time `json:"endTime"`
+	BytesProcessed float64   `json:"bytesProcessed"`
+	GBProcessed    float64   `json:"gbProcessed"`
+	PricePerGB     float64   `json:"pricePerGB"`
+	EstimatedCost  float64   `json:"estimatedCost"`
+}
+
+var dataProcessedSeries = []Metric.Series{
+	{Label: "BytesInFromSource", MetricName: "BytesInFromSource", Statistic: "Sum"},
+	{Label: "BytesInFromDestination", MetricName: "BytesInFromDestination", Statistic: "Sum"},
+}
+
+var AwsxNATGatewayDataProcessingCostCmd = &cobra.Command{
+	Use:   "nat_gateway_data_processing_cost_panel",
+	Short: "get estimated data processing cost of the nat gateway",
+	Long:  `command to estimate the data processing charge of the nat gateway over the selected window`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetNATGatewayDataProcessingCostPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting nat gateway data processing cost: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetNATGatewayDataProcessingCostPanel estimates the data processing charge of the NAT
+// gateway over the window. The hourly charge of the gateway is not included.
+func GetNATGatewayDataProcessingCostPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	natGatewayId, err := GetNATGatewayId(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	pricePerGB := defaultPricePerGB
+	if pricePerGBStr, _ := cmd.PersistentFlags().GetString("pricePerGB"); pricePerGBStr != "" {
+		pricePerGB, err = strconv.ParseFloat(pricePerGBStr, 64)
+		if err != nil || pricePerGB < 0 {
+			return "", nil, fmt.Errorf("invalid pricePerGB %q", pricePerGBStr)
+		}
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, time.Hour)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result, cloudwatchMetricData, err := Metric.GetMetricData(clientAuth, namespace, period, natGatewayDimensions(natGatewayId), dataProcessedSeries, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting data processed metric data: ", err)
+		return "", nil, err
+	}
+
+	cost := DataProcessingCost{
+		StartTime:  *startTime,
+		EndTime:    *endTime,
+		PricePerGB: pricePerGB,
+	}
+	for _, s := range dataProcessedSeries {
+		for _, point := range result[s.Label] {
+			cost.BytesProcessed += point.Value
+		}
+	}
+	// AWS bills data processing per GB of 2^30 bytes
+	cost.GBProcessed = cost.BytesProcessed / (1 << 30)
+	cost.EstimatedCost = cost.GBProcessed * pricePerGB
+
+	jsonString, err := json.Marshal(cost)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func init() {
+	AwsxNATGatewayDataProcessingCostCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxNATGatewayDataProcessingCostCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxNATGatewayDataProcessingCostCmd.PersistentFlags().String("query", "", "query")
+	AwsxNATGatewayDataProcessingCostCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxNATGatewayDataProcessingCostCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxNATGatewayDataProcessingCostCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxNATGatewayDataProcessingCostCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxNATGatewayDataProcessingCostCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxNATGatewayDataProcessingCostCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxNATGatewayDataProcessingCostCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxNATGatewayDataProcessingCostCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxNATGatewayDataProcessingCostCmd.PersistentFlags().String("NatGatewayId", "", "nat gateway id")
+	AwsxNATGatewayDataProcessingCostCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxNATGatewayDataProcessingCostCmd.PersistentFlags().String("pricePerGB", "", "data processing price per GB. defaults to the us-east-1 price")
+	AwsxNATGatewayDataProcessingCostCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxNATGatewayDataProcessingCostCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxNATGatewayDataProcessingCostCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,73 @@
+package NATGateway
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var errorPortAllocationSeries = []Metric.Series{
+	{Label: "ErrorPortAllocation", MetricName: "ErrorPortAllocation", Statistic: "Sum"},
+}
+
+var AwsxNATGatewayErrorPortAllocationCmd = &cobra.Command{
+	Use:   "nat_gateway_error_port_allocation_panel",
+	Short: "get port allocation errors of the nat gateway",
+	Long:  `command to get the number of times the nat gateway could not allocate a source port`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetNATGatewayErrorPortAllocationPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting nat gateway port allocation errors: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetNATGatewayErrorPortAllocationPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetNATGatewayPanel(cmd, clientAuth, errorPortAllocationSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxNATGatewayErrorPortAllocationCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxNATGatewayErrorPortAllocationCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxNATGatewayErrorPortAllocationCmd.PersistentFlags().String("query", "", "query")
+	AwsxNATGatewayErrorPortAllocationCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxNATGatewayErrorPortAllocationCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxNATGatewayErrorPortAllocationCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxNATGatewayErrorPortAllocationCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxNATGatewayErrorPortAllocationCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxNATGatewayErrorPortAllocationCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxNATGatewayErrorPortAllocationCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxNATGatewayErrorPortAllocationCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxNATGatewayErrorPortAllocationCmd.PersistentFlags().String("NatGatewayId", "", "nat gateway id")
+	AwsxNATGatewayErrorPortAllocationCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxNATGatewayErrorPortAllocationCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxNATGatewayErrorPortAllocationCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxNATGatewayErrorPortAllocationCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
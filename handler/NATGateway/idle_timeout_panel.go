@@ -0,0 +1,73 @@
+package NATGateway
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var idleTimeoutSeries = []Metric.Series{
+	{Label: "IdleTimeoutCount", MetricName: "IdleTimeoutCount", Statistic: "Sum"},
+}
+
+var AwsxNATGatewayIdleTimeoutCmd = &cobra.Command{
+	Use:   "nat_gateway_idle_timeout_panel",
+	Short: "get idle timeouts of the nat gateway",
+	Long:  `command to get the number of connections that went from active to idle`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetNATGatewayIdleTimeoutPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting nat gateway idle timeouts: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetNATGatewayIdleTimeoutPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetNATGatewayPanel(cmd, clientAuth, idleTimeoutSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxNATGatewayIdleTimeoutCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxNATGatewayIdleTimeoutCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxNATGatewayIdleTimeoutCmd.PersistentFlags().String("query", "", "query")
+	AwsxNATGatewayIdleTimeoutCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxNATGatewayIdleTimeoutCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxNATGatewayIdleTimeoutCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxNATGatewayIdleTimeoutCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxNATGatewayIdleTimeoutCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxNATGatewayIdleTimeoutCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxNATGatewayIdleTimeoutCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxNATGatewayIdleTimeoutCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxNATGatewayIdleTimeoutCmd.PersistentFlags().String("NatGatewayId", "", "nat gateway id")
+	AwsxNATGatewayIdleTimeoutCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxNATGatewayIdleTimeoutCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxNATGatewayIdleTimeoutCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxNATGatewayIdleTimeoutCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,43 @@
+package NATGateway
+
+import (
+	"errors"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/cmdb"
+	"github.com/Appkube-awsx/awsx-common/config"
+	"github.com/spf13/cobra"
+)
+
+// GetNATGatewayId returns the NAT gateway from the NatGatewayId flag, the CMDB element or
+// the instanceId flag, in that order.
+func GetNATGatewayId(cmd *cobra.Command) (string, error) {
+	natGatewayId, _ := cmd.PersistentFlags().GetString("NatGatewayId")
+	elementId, _ := cmd.PersistentFlags().GetString("elementId")
+	cmdbApiUrl, _ := cmd.PersistentFlags().GetString("cmdbApiUrl")
+	instanceId, _ := cmd.PersistentFlags().GetString("instanceId")
+
+	if natGatewayId != "" {
+		return natGatewayId, nil
+	}
+
+	if elementId != "" {
+		log.Println("getting cloud-element data from cmdb")
+		apiUrl := cmdbApiUrl
+		if cmdbApiUrl == "" {
+			log.Println("using default cmdb url")
+			apiUrl = config.CmdbUrl
+		}
+		log.Println("cmdb url: " + apiUrl)
+		cmdbData, err := cmdb.GetCloudElementData(apiUrl, elementId)
+		if err != nil {
+			return "", err
+		}
+		instanceId = cmdbData.InstanceId
+	}
+
+	if instanceId == "" {
+		return "", errors.New("nat gateway id not provided. provide NatGatewayId or elementId")
+	}
+	return instanceId, nil
+}
@@ -0,0 +1,48 @@
+package NATGateway
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+const namespace = "AWS/NATGateway"
+
+// period is the granularity the NAT gateway metrics are published at
+const period = 60
+
+// GetNATGatewayPanel resolves the NAT gateway and returns the given series of it.
+func GetNATGatewayPanel(cmd *cobra.Command, clientAuth *model.Auth, series []Metric.Series, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	natGatewayId, err := GetNATGatewayId(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result, cloudwatchMetricData, err := Metric.GetMetricData(clientAuth, namespace, period, natGatewayDimensions(natGatewayId), series, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting nat gateway metric data: ", err)
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func natGatewayDimensions(natGatewayId string) []*cloudwatch.Dimension {
+	return Metric.Dimensions("NatGatewayId", natGatewayId)
+}
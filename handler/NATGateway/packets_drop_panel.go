@@ -0,0 +1,73 @@
+package NATGateway
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var packetsDropSeries = []Metric.Series{
+	{Label: "PacketsDropCount", MetricName: "PacketsDropCount", Statistic: "Sum"},
+}
+
+var AwsxNATGatewayPacketsDropCmd = &cobra.Command{
+	Use:   "nat_gateway_packets_drop_panel",
+	Short: "get packets dropped by the nat gateway",
+	Long:  `command to get the number of packets dropped by the nat gateway`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetNATGatewayPacketsDropPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting nat gateway dropped packets: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetNATGatewayPacketsDropPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetNATGatewayPanel(cmd, clientAuth, packetsDropSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxNATGatewayPacketsDropCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxNATGatewayPacketsDropCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxNATGatewayPacketsDropCmd.PersistentFlags().String("query", "", "query")
+	AwsxNATGatewayPacketsDropCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxNATGatewayPacketsDropCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxNATGatewayPacketsDropCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxNATGatewayPacketsDropCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxNATGatewayPacketsDropCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxNATGatewayPacketsDropCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxNATGatewayPacketsDropCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxNATGatewayPacketsDropCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxNATGatewayPacketsDropCmd.PersistentFlags().String("NatGatewayId", "", "nat gateway id")
+	AwsxNATGatewayPacketsDropCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxNATGatewayPacketsDropCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxNATGatewayPacketsDropCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxNATGatewayPacketsDropCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
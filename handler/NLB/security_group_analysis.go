@@ -0,0 +1,568 @@
+package NLB
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/cmdb"
+	"github.com/Appkube-awsx/awsx-common/config"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/spf13/cobra"
+)
+
+const (
+	SeverityHigh   = "HIGH"
+	SeverityMedium = "MEDIUM"
+	SeverityLow    = "LOW"
+
+	// Ingress rules spanning more ports than this are reported as overly broad
+	broadPortRangeThreshold = 100
+)
+
+type NLBSecurityGroupScope struct {
+	LoadBalancerArn string
+	ListenerPorts   []int64
+	NLBGroupIds     []string
+	TargetGroupIds  []string
+	GroupIds        []string
+	Targets         []NLBTarget
+	// SubnetCidrs are the IPv4 blocks of the load balancer subnets, which its health checks
+	// are sent from
+	SubnetCidrs []string
+}
+
+type NLBTarget struct {
+	TargetId        string
+	TargetPort      int64
+	HealthCheckPort int64
+	GroupIds        []string
+}
+
+type SecurityGroupFinding struct {
+	GroupId     string `json:"groupId"`
+	Check       string `json:"check"`
+	Severity    string `json:"severity"`
+	Rule        string `json:"rule,omitempty"`
+	Description string `json:"description"`
+}
+
+type SecurityGroupAnalysis struct {
+	LoadBalancerArn string                 `json:"loadBalancerArn"`
+	ListenerPorts   []int64                `json:"listenerPorts"`
+	SecurityGroups  []SecurityGroupInfo    `json:"securityGroups"`
+	Findings        []SecurityGroupFinding `json:"findings"`
+	Summary         map[string]int         `json:"summary"`
+}
+
+func GetSecurityGroupAnalysis(cmd *cobra.Command, clientAuth *model.Auth) (string, *SecurityGroupAnalysis, error) {
+	scope, err := GetNLBSecurityGroupScope(cmd, clientAuth)
+	if err != nil {
+		return "", nil, err
+	}
+
+	analysis := &SecurityGroupAnalysis{
+		LoadBalancerArn: scope.LoadBalancerArn,
+		ListenerPorts:   scope.ListenerPorts,
+		Findings:        []SecurityGroupFinding{},
+		Summary:         map[string]int{SeverityHigh: 0, SeverityMedium: 0, SeverityLow: 0},
+	}
+
+	if len(scope.GroupIds) > 0 {
+		sgs, err := describeSecurityGroupsById(clientAuth, scope.GroupIds)
+		if err != nil {
+			return "", nil, err
+		}
+		for _, sg := range sgs {
+			analysis.SecurityGroups = append(analysis.SecurityGroups, SecurityGroupInfo{
+				GroupID:       *sg.GroupId,
+				InboundRules:  formatRuleList(formatRules(sg.IpPermissions)),
+				OutboundRules: formatRuleList(formatRules(sg.IpPermissionsEgress)),
+			})
+		}
+		analysis.Findings = analyzeSecurityGroups(scope, sgs)
+	}
+
+	for _, finding := range analysis.Findings {
+		analysis.Summary[finding.Severity]++
+	}
+
+	jsonString, err := json.Marshal(analysis)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+	return string(jsonString), analysis, nil
+}
+
+// GetNLBSecurityGroupScope resolves the selected NLB and collects the security groups
+// attached to it and to the targets registered in its target groups.
+func GetNLBSecurityGroupScope(cmd *cobra.Command, clientAuth *model.Auth) (*NLBSecurityGroupScope, error) {
+	elbClient := awsclient.GetClient(*clientAuth, awsclient.ELBV2_CLIENT).(*elbv2.ELBV2)
+
+	loadBalancer, err := resolveNLB(cmd, elbClient)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := &NLBSecurityGroupScope{
+		LoadBalancerArn: *loadBalancer.LoadBalancerArn,
+		NLBGroupIds:     aws.StringValueSlice(loadBalancer.SecurityGroups),
+	}
+
+	listeners, err := elbClient.DescribeListeners(&elbv2.DescribeListenersInput{LoadBalancerArn: loadBalancer.LoadBalancerArn})
+	if err != nil {
+		log.Printf("Error describing listeners: %v", err)
+		return nil, err
+	}
+	for _, listener := range listeners.Listeners {
+		if listener.Port != nil {
+			scope.ListenerPorts = append(scope.ListenerPorts, *listener.Port)
+		}
+	}
+
+	ec2Client := awsclient.GetClient(*clientAuth, awsclient.EC2_CLIENT).(*ec2.EC2)
+	scope.SubnetCidrs, err = getSubnetCidrs(ec2Client, loadBalancer)
+	if err != nil {
+		return nil, err
+	}
+
+	targetGroups, err := elbClient.DescribeTargetGroups(&elbv2.DescribeTargetGroupsInput{LoadBalancerArn: loadBalancer.LoadBalancerArn})
+	if err != nil {
+		log.Printf("Error describing target groups: %v", err)
+		return nil, err
+	}
+
+	var instanceTargets, ipTargets []*NLBTarget
+	for _, tg := range targetGroups.TargetGroups {
+		targetHealth, err := elbClient.DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{TargetGroupArn: tg.TargetGroupArn})
+		if err != nil {
+			log.Printf("Error describing target health for target group %s: %v", *tg.TargetGroupName, err)
+			continue
+		}
+		for _, description := range targetHealth.TargetHealthDescriptions {
+			target := &NLBTarget{
+				TargetId:   *description.Target.Id,
+				TargetPort: aws.Int64Value(tg.Port),
+			}
+			if description.Target.Port != nil {
+				target.TargetPort = *description.Target.Port
+			}
+			target.HealthCheckPort = target.TargetPort
+			if port, err := strconv.ParseInt(aws.StringValue(tg.HealthCheckPort), 10, 64); err == nil {
+				target.HealthCheckPort = port
+			}
+
+			switch aws.StringValue(tg.TargetType) {
+			case elbv2.TargetTypeEnumInstance:
+				instanceTargets = append(instanceTargets, target)
+			case elbv2.TargetTypeEnumIp:
+				ipTargets = append(ipTargets, target)
+			}
+		}
+	}
+
+	if err := resolveInstanceTargetGroups(ec2Client, instanceTargets); err != nil {
+		return nil, err
+	}
+	if err := resolveIpTargetGroups(ec2Client, ipTargets); err != nil {
+		return nil, err
+	}
+
+	targetGroupIds := map[string]bool{}
+	for _, target := range append(instanceTargets, ipTargets...) {
+		scope.Targets = append(scope.Targets, *target)
+		for _, groupId := range target.GroupIds {
+			targetGroupIds[groupId] = true
+		}
+	}
+	for groupId := range targetGroupIds {
+		scope.TargetGroupIds = append(scope.TargetGroupIds, groupId)
+	}
+	sort.Strings(scope.TargetGroupIds)
+
+	scope.GroupIds = uniqueStrings(append(append([]string{}, scope.NLBGroupIds...), scope.TargetGroupIds...))
+	return scope, nil
+}
+
+func resolveNLB(cmd *cobra.Command, elbClient *elbv2.ELBV2) (*elbv2.LoadBalancer, error) {
+	loadBalancerArn, _ := cmd.PersistentFlags().GetString("loadBalancerArn")
+	instanceId, _ := cmd.PersistentFlags().GetString("instanceId")
+	elementId, _ := cmd.PersistentFlags().GetString("elementId")
+	cmdbApiUrl, _ := cmd.PersistentFlags().GetString("cmdbApiUrl")
+
+	if loadBalancerArn == "" && elementId != "" {
+		log.Println("getting cloud-element data from cmdb")
+		apiUrl := cmdbApiUrl
+		if cmdbApiUrl == "" {
+			log.Println("using default cmdb url")
+			apiUrl = config.CmdbUrl
+		}
+		log.Println("cmdb url: " + apiUrl)
+		cmdbData, err := cmdb.GetCloudElementData(apiUrl, elementId)
+		if err != nil {
+			return nil, err
+		}
+		loadBalancerArn = cmdbData.Arn
+		instanceId = cmdbData.InstanceId
+	}
+
+	if loadBalancerArn != "" {
+		output, err := elbClient.DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{
+			LoadBalancerArns: []*string{aws.String(loadBalancerArn)},
+		})
+		if err != nil {
+			log.Printf("Error describing load balancer %s: %v", loadBalancerArn, err)
+			return nil, err
+		}
+		if len(output.LoadBalancers) > 0 {
+			return output.LoadBalancers[0], nil
+		}
+	}
+
+	// The LoadBalancer dimension value (net/<name>/<id>) is the tail of the ARN
+	if instanceId != "" {
+		var found *elbv2.LoadBalancer
+		err := elbClient.DescribeLoadBalancersPages(&elbv2.DescribeLoadBalancersInput{}, func(page *elbv2.DescribeLoadBalancersOutput, lastPage bool) bool {
+			for _, lb := range page.LoadBalancers {
+				if strings.HasSuffix(aws.StringValue(lb.LoadBalancerArn), "loadbalancer/"+instanceId) {
+					found = lb
+					return false
+				}
+			}
+			return true
+		})
+		if err != nil {
+			log.Printf("Error describing load balancers: %v", err)
+			return nil, err
+		}
+		if found != nil {
+			return found, nil
+		}
+	}
+
+	return nil, fmt.Errorf("load balancer not found. provide loadBalancerArn or elementId")
+}
+
+// getSubnetCidrs returns the IPv4 blocks of the subnets the load balancer is in.
+func getSubnetCidrs(ec2Client *ec2.EC2, loadBalancer *elbv2.LoadBalancer) ([]string, error) {
+	var subnetIds []*string
+	for _, zone := range loadBalancer.AvailabilityZones {
+		if zone.SubnetId != nil {
+			subnetIds = append(subnetIds, zone.SubnetId)
+		}
+	}
+	if len(subnetIds) == 0 {
+		return nil, nil
+	}
+
+	output, err := ec2Client.DescribeSubnets(&ec2.DescribeSubnetsInput{SubnetIds: subnetIds})
+	if err != nil {
+		log.Printf("Error describing load balancer subnets: %v", err)
+		return nil, err
+	}
+	var cidrs []string
+	for _, subnet := range output.Subnets {
+		cidrs = append(cidrs, aws.StringValue(subnet.CidrBlock))
+	}
+	return cidrs, nil
+}
+
+func resolveInstanceTargetGroups(ec2Client *ec2.EC2, targets []*NLBTarget) error {
+	if len(targets) == 0 {
+		return nil
+	}
+	byInstance := map[string][]*NLBTarget{}
+	var instanceIds []string
+	for _, target := range targets {
+		if _, ok := byInstance[target.TargetId]; !ok {
+			instanceIds = append(instanceIds, target.TargetId)
+		}
+		byInstance[target.TargetId] = append(byInstance[target.TargetId], target)
+	}
+
+	err := ec2Client.DescribeInstancesPages(&ec2.DescribeInstancesInput{InstanceIds: aws.StringSlice(instanceIds)}, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+		for _, reservation := range page.Reservations {
+			for _, instance := range reservation.Instances {
+				var groupIds []string
+				for _, group := range instance.SecurityGroups {
+					groupIds = append(groupIds, *group.GroupId)
+				}
+				for _, target := range byInstance[aws.StringValue(instance.InstanceId)] {
+					target.GroupIds = groupIds
+				}
+			}
+		}
+		return true
+	})
+	if err != nil {
+		log.Printf("Error describing target instances: %v", err)
+	}
+	return err
+}
+
+func resolveIpTargetGroups(ec2Client *ec2.EC2, targets []*NLBTarget) error {
+	if len(targets) == 0 {
+		return nil
+	}
+	byIp := map[string][]*NLBTarget{}
+	var ips []string
+	for _, target := range targets {
+		if _, ok := byIp[target.TargetId]; !ok {
+			ips = append(ips, target.TargetId)
+		}
+		byIp[target.TargetId] = append(byIp[target.TargetId], target)
+	}
+
+	input := &ec2.DescribeNetworkInterfacesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("addresses.private-ip-address"),
+				Values: aws.StringSlice(ips),
+			},
+		},
+	}
+	err := ec2Client.DescribeNetworkInterfacesPages(input, func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+		for _, eni := range page.NetworkInterfaces {
+			var groupIds []string
+			for _, group := range eni.Groups {
+				groupIds = append(groupIds, *group.GroupId)
+			}
+			for _, address := range eni.PrivateIpAddresses {
+				for _, target := range byIp[aws.StringValue(address.PrivateIpAddress)] {
+					target.GroupIds = groupIds
+				}
+			}
+		}
+		return true
+	})
+	if err != nil {
+		log.Printf("Error describing target network interfaces: %v", err)
+	}
+	return err
+}
+
+func analyzeSecurityGroups(scope *NLBSecurityGroupScope, sgs []*ec2.SecurityGroup) []SecurityGroupFinding {
+	findings := []SecurityGroupFinding{}
+	byId := map[string]*ec2.SecurityGroup{}
+
+	// Targets behind an NLB without security groups see client addresses, so the
+	// target ports are expected to be reachable from the internet as well.
+	publicPorts := map[int64]bool{}
+	for _, port := range scope.ListenerPorts {
+		publicPorts[port] = true
+	}
+	targetPublicPorts := map[int64]bool{}
+	for port := range publicPorts {
+		targetPublicPorts[port] = true
+	}
+	if len(scope.NLBGroupIds) == 0 {
+		for _, target := range scope.Targets {
+			targetPublicPorts[target.TargetPort] = true
+		}
+	}
+	isTargetGroup := map[string]bool{}
+	for _, groupId := range scope.TargetGroupIds {
+		isTargetGroup[groupId] = true
+	}
+
+	for _, sg := range sgs {
+		groupId := *sg.GroupId
+		byId[groupId] = sg
+
+		allowed := publicPorts
+		if isTargetGroup[groupId] {
+			allowed = targetPublicPorts
+		}
+
+		for _, rule := range sg.IpPermissions {
+			// Port ranges mean nothing for ICMP and other protocols without ports
+			if !hasPorts(rule) {
+				continue
+			}
+			fromPort, toPort := rulePortRange(rule)
+			ruleDesc := describeRule(rule)
+
+			if isOpenToWorld(rule) && !portRangeWithin(fromPort, toPort, allowed) {
+				findings = append(findings, SecurityGroupFinding{
+					GroupId:     groupId,
+					Check:       "open_ingress_non_listener_port",
+					Severity:    SeverityHigh,
+					Rule:        ruleDesc,
+					Description: fmt.Sprintf("ingress from the internet is allowed on ports %d-%d which are not served by the load balancer", fromPort, toPort),
+				})
+			}
+
+			if toPort-fromPort+1 > broadPortRangeThreshold {
+				findings = append(findings, SecurityGroupFinding{
+					GroupId:     groupId,
+					Check:       "broad_port_range",
+					Severity:    SeverityMedium,
+					Rule:        ruleDesc,
+					Description: fmt.Sprintf("ingress rule spans %d ports", toPort-fromPort+1),
+				})
+			}
+		}
+	}
+
+	for _, target := range scope.Targets {
+		if len(target.GroupIds) == 0 {
+			continue
+		}
+		allowed := false
+		for _, groupId := range target.GroupIds {
+			sg, ok := byId[groupId]
+			if !ok {
+				continue
+			}
+			for _, rule := range sg.IpPermissions {
+				if !isTcpRule(rule) || !allowsHealthChecks(rule, scope) {
+					continue
+				}
+				fromPort, toPort := rulePortRange(rule)
+				if target.HealthCheckPort >= fromPort && target.HealthCheckPort <= toPort {
+					allowed = true
+				}
+			}
+		}
+		if !allowed {
+			findings = append(findings, SecurityGroupFinding{
+				GroupId:     strings.Join(target.GroupIds, ","),
+				Check:       "health_check_port_blocked",
+				Severity:    SeverityHigh,
+				Description: fmt.Sprintf("no ingress rule allows health checks from the load balancer subnets on port %d for target %s", target.HealthCheckPort, target.TargetId),
+			})
+		}
+	}
+
+	return findings
+}
+
+// rulePortRange returns the ports a TCP, UDP or all protocol rule covers. Protocol -1
+// covers every port.
+func rulePortRange(rule *ec2.IpPermission) (int64, int64) {
+	if aws.StringValue(rule.IpProtocol) == "-1" || rule.FromPort == nil || rule.ToPort == nil {
+		return 0, 65535
+	}
+	return *rule.FromPort, *rule.ToPort
+}
+
+// hasPorts reports whether the rule protocol has ports, i.e. is TCP, UDP or all protocols.
+func hasPorts(rule *ec2.IpPermission) bool {
+	switch aws.StringValue(rule.IpProtocol) {
+	case "-1", "tcp", "6", "udp", "17":
+		return true
+	}
+	return false
+}
+
+// allowsHealthChecks reports whether the rule source covers where the load balancer sends
+// health checks from: a security group of the load balancer, or a CIDR containing all of
+// its subnets, e.g. the VPC CIDR.
+func allowsHealthChecks(rule *ec2.IpPermission, scope *NLBSecurityGroupScope) bool {
+	for _, pair := range rule.UserIdGroupPairs {
+		for _, groupId := range scope.NLBGroupIds {
+			if aws.StringValue(pair.GroupId) == groupId {
+				return true
+			}
+		}
+	}
+	if len(scope.SubnetCidrs) == 0 {
+		return false
+	}
+	for _, ipRange := range rule.IpRanges {
+		if cidrContainsAll(aws.StringValue(ipRange.CidrIp), scope.SubnetCidrs) {
+			return true
+		}
+	}
+	return false
+}
+
+func cidrContainsAll(cidr string, subnets []string) bool {
+	_, outer, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return false
+	}
+	outerOnes, _ := outer.Mask.Size()
+	for _, subnet := range subnets {
+		_, inner, err := net.ParseCIDR(subnet)
+		if err != nil {
+			return false
+		}
+		innerOnes, _ := inner.Mask.Size()
+		if innerOnes < outerOnes || !outer.Contains(inner.IP) {
+			return false
+		}
+	}
+	return true
+}
+
+func isTcpRule(rule *ec2.IpPermission) bool {
+	protocol := aws.StringValue(rule.IpProtocol)
+	return protocol == "-1" || protocol == "tcp" || protocol == "6"
+}
+
+func isOpenToWorld(rule *ec2.IpPermission) bool {
+	for _, ipRange := range rule.IpRanges {
+		if aws.StringValue(ipRange.CidrIp) == "0.0.0.0/0" {
+			return true
+		}
+	}
+	for _, ipRange := range rule.Ipv6Ranges {
+		if aws.StringValue(ipRange.CidrIpv6) == "::/0" {
+			return true
+		}
+	}
+	return false
+}
+
+func portRangeWithin(fromPort, toPort int64, ports map[int64]bool) bool {
+	if toPort-fromPort+1 > int64(len(ports)) {
+		return false
+	}
+	for port := fromPort; port <= toPort; port++ {
+		if !ports[port] {
+			return false
+		}
+	}
+	return true
+}
+
+func describeRule(rule *ec2.IpPermission) string {
+	ports := "n/a"
+	if hasPorts(rule) {
+		fromPort, toPort := rulePortRange(rule)
+		ports = fmt.Sprintf("%d-%d", fromPort, toPort)
+	}
+	var sources []string
+	for _, ipRange := range rule.IpRanges {
+		sources = append(sources, aws.StringValue(ipRange.CidrIp))
+	}
+	for _, ipRange := range rule.Ipv6Ranges {
+		sources = append(sources, aws.StringValue(ipRange.CidrIpv6))
+	}
+	for _, pair := range rule.UserIdGroupPairs {
+		sources = append(sources, aws.StringValue(pair.GroupId))
+	}
+	return fmt.Sprintf("Protocol: %s, Ports: %s, Sources: %s", aws.StringValue(rule.IpProtocol), ports, strings.Join(sources, " "))
+}
+
+func uniqueStrings(values []string) []string {
+	seen := map[string]bool{}
+	var result []string
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			result = append(result, value)
+		}
+	}
+	return result
+}
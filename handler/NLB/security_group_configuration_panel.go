@@ -9,6 +9,7 @@ import (
 	"github.com/Appkube-awsx/awsx-common/authenticate"
 	"github.com/Appkube-awsx/awsx-common/awsclient"
 	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
@@ -34,7 +35,17 @@ var AwsxSecurityGroupCmd = &cobra.Command{
 
 		if authFlag {
 			responseType, _ := cmd.PersistentFlags().GetString("responseType")
-			securityGroups, printresp, err := GetSecurityGroupConfigurations(clientAuth)
+			mode, _ := cmd.PersistentFlags().GetString("mode")
+			if mode == "analysis" {
+				jsonResp, _, err := GetSecurityGroupAnalysis(cmd, clientAuth)
+				if err != nil {
+					log.Println("Error getting security group analysis:", err)
+					return
+				}
+				fmt.Println(jsonResp)
+				return
+			}
+			securityGroups, printresp, err := GetSecurityGroupConfigurations(cmd, clientAuth)
 			if err != nil {
 				log.Println("Error getting security group configurations:", err)
 				return
@@ -49,9 +60,15 @@ var AwsxSecurityGroupCmd = &cobra.Command{
 	},
 }
 
-func GetSecurityGroupConfigurations(clientAuth *model.Auth) ([]SecurityGroupInfo, string, error) {
+func GetSecurityGroupConfigurations(cmd *cobra.Command, clientAuth *model.Auth) ([]SecurityGroupInfo, string, error) {
+	// Limit the panel to the groups of the selected NLB and its targets
+	scope, err := GetNLBSecurityGroupScope(cmd, clientAuth)
+	if err != nil {
+		return nil, "", err
+	}
+
 	// Retrieve security group configurations
-	securityGroups, err := DescribeSecurityGroups(clientAuth)
+	securityGroups, err := DescribeSecurityGroups(clientAuth, scope.GroupIds)
 	if err != nil {
 		return nil, "", err
 	}
@@ -59,20 +76,19 @@ func GetSecurityGroupConfigurations(clientAuth *model.Auth) ([]SecurityGroupInfo
 	return securityGroups, formattedTable, nil
 }
 
-func DescribeSecurityGroups(clientAuth *model.Auth) ([]SecurityGroupInfo, error) {
-	// Use existing AWS client
-	svc := awsclient.GetClient(*clientAuth, awsclient.EC2_CLIENT).(*ec2.EC2)
+func DescribeSecurityGroups(clientAuth *model.Auth, groupIds []string) ([]SecurityGroupInfo, error) {
+	if len(groupIds) == 0 {
+		return nil, nil
+	}
 
-	// Describe security groups
-	describeSGInput := &ec2.DescribeSecurityGroupsInput{}
-	describeSGOutput, err := svc.DescribeSecurityGroups(describeSGInput)
+	sgs, err := describeSecurityGroupsById(clientAuth, groupIds)
 	if err != nil {
-		log.Fatalf("Error describing security groups: %v", err)
+		return nil, err
 	}
 
 	// Retrieve security group configurations
 	var securityGroups []SecurityGroupInfo
-	for _, sg := range describeSGOutput.SecurityGroups {
+	for _, sg := range sgs {
 		inboundRules := formatRules(sg.IpPermissions)
 		outboundRules := formatRules(sg.IpPermissionsEgress)
 
@@ -87,6 +103,26 @@ func DescribeSecurityGroups(clientAuth *model.Auth) ([]SecurityGroupInfo, error)
 	return securityGroups, nil
 }
 
+func describeSecurityGroupsById(clientAuth *model.Auth, groupIds []string) ([]*ec2.SecurityGroup, error) {
+	// Use existing AWS client
+	svc := awsclient.GetClient(*clientAuth, awsclient.EC2_CLIENT).(*ec2.EC2)
+
+	// Describe security groups
+	describeSGInput := &ec2.DescribeSecurityGroupsInput{
+		GroupIds: aws.StringSlice(groupIds),
+	}
+	var securityGroups []*ec2.SecurityGroup
+	err := svc.DescribeSecurityGroupsPages(describeSGInput, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+		securityGroups = append(securityGroups, page.SecurityGroups...)
+		return true
+	})
+	if err != nil {
+		log.Printf("Error describing security groups: %v", err)
+		return nil, err
+	}
+	return securityGroups, nil
+}
+
 func formatRules(rules []*ec2.IpPermission) []string {
 	var formattedRules []string
 	for _, rule := range rules {
@@ -146,5 +182,9 @@ func printSecurityGroups(securityGroups []SecurityGroupInfo) string {
 }
 
 func init() {
+	AwsxSecurityGroupCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxSecurityGroupCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxSecurityGroupCmd.PersistentFlags().String("loadBalancerArn", "", "NLB Load Balancer ARN")
+	AwsxSecurityGroupCmd.PersistentFlags().String("mode", "", "panel mode. analysis returns security findings")
 	AwsxSecurityGroupCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
 }
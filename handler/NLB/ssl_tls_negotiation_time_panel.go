@@ -7,12 +7,12 @@ import (
 	"time"
 
 	"github.com/Appkube-awsx/awsx-common/authenticate"
-	"github.com/Appkube-awsx/awsx-common/awsclient"
 
 	"github.com/Appkube-awsx/awsx-common/cmdb"
 	"github.com/Appkube-awsx/awsx-common/config"
 	"github.com/Appkube-awsx/awsx-common/model"
-	"github.com/aws/aws-sdk-go/aws"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Statistic"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/spf13/cobra"
 )
@@ -68,6 +68,11 @@ func GetSSLTLSNegotiationDataData(cmd *cobra.Command, clientAuth *model.Auth, cl
 	startTimeStr, _ := cmd.PersistentFlags().GetString("startTime")
 	endTimeStr, _ := cmd.PersistentFlags().GetString("endTime")
 
+	statistics, err := Statistic.GetStatistics(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
 	if elementId != "" {
 		log.Println("getting cloud-element data from cmdb")
 		apiUrl := cmdbApiUrl
@@ -114,15 +119,33 @@ func GetSSLTLSNegotiationDataData(cmd *cobra.Command, clientAuth *model.Auth, cl
 	// Debug prints
 	log.Printf("StartTime: %v, EndTime: %v", startTime, endTime)
 
+	if statistics != nil {
+		rawData, cloudwatchMetricData, err := GetSSLTLSNegotiationDataMetricData(clientAuth, instanceId, elementType, startTime, endTime, statistics, cloudWatchClient)
+		if err != nil {
+			log.Println("Error in getting raw data: ", err)
+			return "", nil, err
+		}
+		results := map[string]SSLTLSNegotiationDataa{}
+		for statistic, points := range rawData {
+			results[statistic] = processssltlsrawdata(points)
+		}
+		jsonString, err := json.Marshal(results)
+		if err != nil {
+			log.Println("Error in marshalling json in string: ", err)
+			return "", nil, err
+		}
+		return string(jsonString), cloudwatchMetricData, nil
+	}
+
 	// Fetch raw data
-	rawData, err := GetSSLTLSNegotiationDataMetricData(clientAuth, instanceId, elementType, startTime, endTime, cloudWatchClient)
+	rawData, _, err := GetSSLTLSNegotiationDataMetricData(clientAuth, instanceId, elementType, startTime, endTime, []string{"Average"}, cloudWatchClient)
 	if err != nil {
 		log.Println("Error in getting raw data: ", err)
 		return "", nil, err
 	}
 
 	// Process the raw data if needed
-	result := processssltlsrawdata(rawData)
+	result := processssltlsrawdata(rawData["Average"])
 
 	// Collect all timestamps and values separately
 	timestamps := make([]time.Time, len(result.SSLTLSNegotiationData))
@@ -162,77 +185,32 @@ func GetSSLTLSNegotiationDataData(cmd *cobra.Command, clientAuth *model.Auth, cl
 	return string(jsonString), cloudwatchMetricData, nil
 }
 
-func GetSSLTLSNegotiationDataMetricData(clientAuth *model.Auth, instanceId string, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+// GetSSLTLSNegotiationDataMetricData returns the client plus target TLS negotiation error
+// counts of the NLB for every statistic, keyed by statistic, in a single request.
+func GetSSLTLSNegotiationDataMetricData(clientAuth *model.Auth, instanceId string, elementType string, startTime, endTime *time.Time, statistics []string, cloudWatchClient *cloudwatch.CloudWatch) (map[string][]Metric.DataPoint, map[string]*cloudwatch.GetMetricDataOutput, error) {
 
 	log.Printf("Getting SSL/TLS negotiation metric data for NLB %s from %v to %v", instanceId, startTime, endTime)
 
-	// Replace this block with actual code to fetch SSL/TLS negotiation metrics from CloudWatch
-	input := &cloudwatch.GetMetricDataInput{
-		EndTime:   endTime,
-		StartTime: startTime,
-		MetricDataQueries: []*cloudwatch.MetricDataQuery{
-			{
-				Id: aws.String("clientTLSNegotiation"),
-				MetricStat: &cloudwatch.MetricStat{
-					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("LoadBalancer"),
-								Value: aws.String(instanceId),
-							},
-						},
-						MetricName: aws.String("ClientTLSNegotiationErrorCount"),
-						Namespace:  aws.String("AWS/NetworkELB"),
-					},
-					Period: aws.Int64(60),
-					Stat:   aws.String("Average"),
-				},
-			},
-			{
-				Id: aws.String("targetTLSNegotiation"),
-				MetricStat: &cloudwatch.MetricStat{
-					Metric: &cloudwatch.Metric{
-						Dimensions: []*cloudwatch.Dimension{
-							{
-								Name:  aws.String("LoadBalancer"),
-								Value: aws.String(instanceId),
-							},
-						},
-						MetricName: aws.String("TargetTLSNegotiationErrorCount"),
-						Namespace:  aws.String("AWS/NetworkELB"),
-					},
-					Period: aws.Int64(60),
-					Stat:   aws.String("Average"),
-				},
+	var series []Metric.Series
+	for _, statistic := range statistics {
+		series = append(series, Metric.Series{
+			Label:      statistic,
+			Expression: "{0} + {1}",
+			Metrics: []Metric.MetricStat{
+				{MetricName: "ClientTLSNegotiationErrorCount", Statistic: statistic},
+				{MetricName: "TargetTLSNegotiationErrorCount", Statistic: statistic},
 			},
-		},
-	}
-
-	if cloudWatchClient == nil {
-		cloudWatchClient = awsclient.GetClient(*clientAuth, awsclient.CLOUDWATCH).(*cloudwatch.CloudWatch)
-	}
-	result, err := cloudWatchClient.GetMetricData(input)
-	if err != nil {
-		return nil, err
+		})
 	}
-
-	return result, nil
+	return Metric.GetMetricData(clientAuth, "AWS/NetworkELB", 60, Metric.Dimensions("LoadBalancer", instanceId), series, startTime, endTime, cloudWatchClient)
 }
 
-func processssltlsrawdata(result *cloudwatch.GetMetricDataOutput) SSLTLSNegotiationDataa {
+func processssltlsrawdata(points []Metric.DataPoint) SSLTLSNegotiationDataa {
 	var rawData SSLTLSNegotiationDataa
-	rawData.SSLTLSNegotiationData = make([]SSLTLSNegotiationData, len(result.MetricDataResults[0].Timestamps))
-
-	// Assuming the two metrics have the same number of data points
-	for i, timestamp := range result.MetricDataResults[0].Timestamps {
-		rawData.SSLTLSNegotiationData[i].Timestamp = *timestamp
-
-		clientTLSNegotiationErrorCount := *result.MetricDataResults[0].Values[i]
-		targetTLSNegotiationErrorCount := *result.MetricDataResults[1].Values[i]
-
-		SSLTLSNegotiationData := clientTLSNegotiationErrorCount + targetTLSNegotiationErrorCount
-
-		rawData.SSLTLSNegotiationData[i].SSLTLSNegotiationData = SSLTLSNegotiationData
+	rawData.SSLTLSNegotiationData = make([]SSLTLSNegotiationData, len(points))
+	for i, point := range points {
+		rawData.SSLTLSNegotiationData[i].Timestamp = point.Timestamp
+		rawData.SSLTLSNegotiationData[i].SSLTLSNegotiationData = point.Value
 	}
 	return rawData
 }
@@ -242,4 +220,5 @@ func init() {
 	AwsxNLBSSLTLSNegotiationCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxNLBSSLTLSNegotiationCmd.PersistentFlags().String("endTime", "", "end time")
 	AwsxNLBSSLTLSNegotiationCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+	AwsxNLBSSLTLSNegotiationCmd.PersistentFlags().String("stat", "", "comma separated statistics, e.g. p50,p90,p99,TM90,IQM")
 }
@@ -0,0 +1,74 @@
+package OpenSearch
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var automatedSnapshotFailureSeries = []Metric.Series{
+	{Label: "AutomatedSnapshotFailure", MetricName: "AutomatedSnapshotFailure", Statistic: "Maximum"},
+}
+
+var AwsxOpenSearchAutomatedSnapshotFailureCmd = &cobra.Command{
+	Use:   "opensearch_automated_snapshot_failure_panel",
+	Short: "get automated snapshot failures of the domain",
+	Long:  `command to get whether the automated snapshots of the domain failed`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetOpenSearchAutomatedSnapshotFailurePanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting opensearch automated snapshot failures: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetOpenSearchAutomatedSnapshotFailurePanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetOpenSearchDomainPanel(cmd, clientAuth, automatedSnapshotFailureSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxOpenSearchAutomatedSnapshotFailureCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxOpenSearchAutomatedSnapshotFailureCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxOpenSearchAutomatedSnapshotFailureCmd.PersistentFlags().String("query", "", "query")
+	AwsxOpenSearchAutomatedSnapshotFailureCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxOpenSearchAutomatedSnapshotFailureCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxOpenSearchAutomatedSnapshotFailureCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxOpenSearchAutomatedSnapshotFailureCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxOpenSearchAutomatedSnapshotFailureCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxOpenSearchAutomatedSnapshotFailureCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxOpenSearchAutomatedSnapshotFailureCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxOpenSearchAutomatedSnapshotFailureCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxOpenSearchAutomatedSnapshotFailureCmd.PersistentFlags().String("DomainName", "", "opensearch domain name or arn")
+	AwsxOpenSearchAutomatedSnapshotFailureCmd.PersistentFlags().String("ClientId", "", "account id of the domain. looked up when not given")
+	AwsxOpenSearchAutomatedSnapshotFailureCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxOpenSearchAutomatedSnapshotFailureCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxOpenSearchAutomatedSnapshotFailureCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxOpenSearchAutomatedSnapshotFailureCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,76 @@
+package OpenSearch
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var clusterStatusSeries = []Metric.Series{
+	{Label: "ClusterStatus.green", MetricName: "ClusterStatus.green", Statistic: "Maximum"},
+	{Label: "ClusterStatus.yellow", MetricName: "ClusterStatus.yellow", Statistic: "Maximum"},
+	{Label: "ClusterStatus.red", MetricName: "ClusterStatus.red", Statistic: "Maximum"},
+}
+
+var AwsxOpenSearchClusterStatusCmd = &cobra.Command{
+	Use:   "opensearch_cluster_status_panel",
+	Short: "get cluster status of the domain",
+	Long:  `command to get whether the domain cluster was green, yellow or red`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetOpenSearchClusterStatusPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting opensearch cluster status: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetOpenSearchClusterStatusPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetOpenSearchDomainPanel(cmd, clientAuth, clusterStatusSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxOpenSearchClusterStatusCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxOpenSearchClusterStatusCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxOpenSearchClusterStatusCmd.PersistentFlags().String("query", "", "query")
+	AwsxOpenSearchClusterStatusCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxOpenSearchClusterStatusCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxOpenSearchClusterStatusCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxOpenSearchClusterStatusCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxOpenSearchClusterStatusCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxOpenSearchClusterStatusCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxOpenSearchClusterStatusCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxOpenSearchClusterStatusCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxOpenSearchClusterStatusCmd.PersistentFlags().String("DomainName", "", "opensearch domain name or arn")
+	AwsxOpenSearchClusterStatusCmd.PersistentFlags().String("ClientId", "", "account id of the domain. looked up when not given")
+	AwsxOpenSearchClusterStatusCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxOpenSearchClusterStatusCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxOpenSearchClusterStatusCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxOpenSearchClusterStatusCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
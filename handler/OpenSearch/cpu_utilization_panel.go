@@ -0,0 +1,75 @@
+package OpenSearch
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var cpuUtilizationSeries = []Metric.Series{
+	{Label: "MaxCPUUtilization", MetricName: "CPUUtilization", Statistic: "Maximum"},
+	{Label: "AvgCPUUtilization", MetricName: "CPUUtilization", Statistic: "Average"},
+}
+
+var AwsxOpenSearchCPUUtilizationCmd = &cobra.Command{
+	Use:   "opensearch_cpu_utilization_panel",
+	Short: "get cpu utilization of the domain",
+	Long:  `command to get the highest and average cpu utilization across the data nodes of the domain`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetOpenSearchCPUUtilizationPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting opensearch cpu utilization: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetOpenSearchCPUUtilizationPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetOpenSearchDomainPanel(cmd, clientAuth, cpuUtilizationSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxOpenSearchCPUUtilizationCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxOpenSearchCPUUtilizationCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxOpenSearchCPUUtilizationCmd.PersistentFlags().String("query", "", "query")
+	AwsxOpenSearchCPUUtilizationCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxOpenSearchCPUUtilizationCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxOpenSearchCPUUtilizationCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxOpenSearchCPUUtilizationCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxOpenSearchCPUUtilizationCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxOpenSearchCPUUtilizationCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxOpenSearchCPUUtilizationCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxOpenSearchCPUUtilizationCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxOpenSearchCPUUtilizationCmd.PersistentFlags().String("DomainName", "", "opensearch domain name or arn")
+	AwsxOpenSearchCPUUtilizationCmd.PersistentFlags().String("ClientId", "", "account id of the domain. looked up when not given")
+	AwsxOpenSearchCPUUtilizationCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxOpenSearchCPUUtilizationCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxOpenSearchCPUUtilizationCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxOpenSearchCPUUtilizationCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,92 @@
+package OpenSearch
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/cmdb"
+	"github.com/Appkube-awsx/awsx-common/config"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/spf13/cobra"
+)
+
+// GetOpenSearchDomainName returns the domain from the DomainName flag, the CMDB element or
+// the instanceId flag, in that order. A domain ARN is accepted in place of the name.
+func GetOpenSearchDomainName(cmd *cobra.Command) (string, error) {
+	domainName, _ := cmd.PersistentFlags().GetString("DomainName")
+	elementId, _ := cmd.PersistentFlags().GetString("elementId")
+	cmdbApiUrl, _ := cmd.PersistentFlags().GetString("cmdbApiUrl")
+	instanceId, _ := cmd.PersistentFlags().GetString("instanceId")
+
+	if domainName != "" {
+		return domainName, nil
+	}
+
+	if elementId != "" {
+		log.Println("getting cloud-element data from cmdb")
+		apiUrl := cmdbApiUrl
+		if cmdbApiUrl == "" {
+			log.Println("using default cmdb url")
+			apiUrl = config.CmdbUrl
+		}
+		log.Println("cmdb url: " + apiUrl)
+		cmdbData, err := cmdb.GetCloudElementData(apiUrl, elementId)
+		if err != nil {
+			return "", err
+		}
+		instanceId = cmdbData.InstanceId
+		if cmdbData.Arn != "" {
+			instanceId = cmdbData.Arn
+		}
+	}
+
+	if instanceId == "" {
+		return "", errors.New("domain name not provided. provide DomainName or elementId")
+	}
+	return instanceId, nil
+}
+
+// GetOpenSearchDomain returns the ClientId and DomainName dimension values of the domain.
+// Every AWS/ES metric needs both. The ClientId is the account id of the domain, taken from
+// the ClientId flag or else from the domain ARN, describing the domain when only its name
+// is known.
+func GetOpenSearchDomain(cmd *cobra.Command, clientAuth *model.Auth) (string, string, error) {
+	domain, err := GetOpenSearchDomainName(cmd)
+	if err != nil {
+		return "", "", err
+	}
+	domainName := domain[strings.LastIndex(domain, "/")+1:]
+
+	clientId, _ := cmd.PersistentFlags().GetString("ClientId")
+	if clientId != "" {
+		return clientId, domainName, nil
+	}
+
+	domainArn := domain
+	if !strings.HasPrefix(domain, "arn:") {
+		output, err := getOpenSearchClient(clientAuth).DescribeDomain(&opensearchservice.DescribeDomainInput{
+			DomainName: aws.String(domainName),
+		})
+		if err != nil {
+			log.Printf("Error describing domain %s: %v", domainName, err)
+			return "", "", err
+		}
+		domainArn = aws.StringValue(output.DomainStatus.ARN)
+	}
+
+	// arn:<partition>:es:<region>:<account>:domain/<name>
+	arnFields := strings.Split(domainArn, ":")
+	if len(arnFields) < 6 {
+		return "", "", fmt.Errorf("invalid domain arn %s", domainArn)
+	}
+	return arnFields[4], domainName, nil
+}
+
+func getOpenSearchClient(clientAuth *model.Auth) *opensearchservice.OpenSearchService {
+	return awsclient.GetClient(*clientAuth, awsclient.OPENSEARCHSERVICE_CLIENT).(*opensearchservice.OpenSearchService)
+}
@@ -0,0 +1,122 @@
+package OpenSearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var freeStorageSeries = []Metric.Series{
+	{Label: "FreeStorageSpace", MetricName: "FreeStorageSpace", Statistic: "Minimum"},
+}
+
+var AwsxOpenSearchFreeStorageCmd = &cobra.Command{
+	Use:   "opensearch_free_storage_panel",
+	Short: "get free storage space of the domain nodes",
+	Long:  `command to get the free storage space of every node of the domain`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetOpenSearchFreeStoragePanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting opensearch free storage: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetOpenSearchFreeStoragePanel returns the free storage space in megabytes of every node of
+// the domain, keyed by node id. The nodes are found with ListMetrics, as the per node
+// metrics carry a NodeId dimension next to ClientId and DomainName.
+func GetOpenSearchFreeStoragePanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	clientId, domainName, err := GetOpenSearchDomain(cmd, clientAuth)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if cloudWatchClient == nil {
+		cloudWatchClient = awsclient.GetClient(*clientAuth, awsclient.CLOUDWATCH).(*cloudwatch.CloudWatch)
+	}
+
+	metrics, err := Metric.ListMetrics(cloudWatchClient, namespace, "FreeStorageSpace", []*cloudwatch.DimensionFilter{
+		{Name: aws.String("ClientId"), Value: aws.String(clientId)},
+		{Name: aws.String("DomainName"), Value: aws.String(domainName)},
+		{Name: aws.String("NodeId")},
+	})
+	if err != nil {
+		log.Println("Error in listing node metrics: ", err)
+		return "", nil, err
+	}
+
+	nodeDimensions := map[string][]*cloudwatch.Dimension{}
+	for _, metric := range metrics {
+		nodeDimensions[Metric.DimensionValue(metric.Dimensions, "NodeId")] = metric.Dimensions
+	}
+
+	result, cloudwatchMetricData, err := Metric.GetEntityMetricData(clientAuth, namespace, period, nodeDimensions, freeStorageSeries, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting node metric data: ", err)
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func init() {
+	AwsxOpenSearchFreeStorageCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxOpenSearchFreeStorageCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxOpenSearchFreeStorageCmd.PersistentFlags().String("query", "", "query")
+	AwsxOpenSearchFreeStorageCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxOpenSearchFreeStorageCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxOpenSearchFreeStorageCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxOpenSearchFreeStorageCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxOpenSearchFreeStorageCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxOpenSearchFreeStorageCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxOpenSearchFreeStorageCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxOpenSearchFreeStorageCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxOpenSearchFreeStorageCmd.PersistentFlags().String("DomainName", "", "opensearch domain name or arn")
+	AwsxOpenSearchFreeStorageCmd.PersistentFlags().String("ClientId", "", "account id of the domain. looked up when not given")
+	AwsxOpenSearchFreeStorageCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxOpenSearchFreeStorageCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxOpenSearchFreeStorageCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxOpenSearchFreeStorageCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,74 @@
+package OpenSearch
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var jvmMemoryPressureSeries = []Metric.Series{
+	{Label: "JVMMemoryPressure", MetricName: "JVMMemoryPressure", Statistic: "Maximum"},
+}
+
+var AwsxOpenSearchJVMMemoryPressureCmd = &cobra.Command{
+	Use:   "opensearch_jvm_memory_pressure_panel",
+	Short: "get jvm memory pressure of the domain",
+	Long:  `command to get the highest jvm memory pressure across the data nodes of the domain`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetOpenSearchJVMMemoryPressurePanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting opensearch jvm memory pressure: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetOpenSearchJVMMemoryPressurePanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetOpenSearchDomainPanel(cmd, clientAuth, jvmMemoryPressureSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxOpenSearchJVMMemoryPressureCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxOpenSearchJVMMemoryPressureCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxOpenSearchJVMMemoryPressureCmd.PersistentFlags().String("query", "", "query")
+	AwsxOpenSearchJVMMemoryPressureCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxOpenSearchJVMMemoryPressureCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxOpenSearchJVMMemoryPressureCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxOpenSearchJVMMemoryPressureCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxOpenSearchJVMMemoryPressureCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxOpenSearchJVMMemoryPressureCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxOpenSearchJVMMemoryPressureCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxOpenSearchJVMMemoryPressureCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxOpenSearchJVMMemoryPressureCmd.PersistentFlags().String("DomainName", "", "opensearch domain name or arn")
+	AwsxOpenSearchJVMMemoryPressureCmd.PersistentFlags().String("ClientId", "", "account id of the domain. looked up when not given")
+	AwsxOpenSearchJVMMemoryPressureCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxOpenSearchJVMMemoryPressureCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxOpenSearchJVMMemoryPressureCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxOpenSearchJVMMemoryPressureCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
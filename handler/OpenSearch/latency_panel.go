@@ -0,0 +1,75 @@
+package OpenSearch
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var latencySeries = []Metric.Series{
+	{Label: "SearchLatency", MetricName: "SearchLatency", Statistic: "Average"},
+	{Label: "IndexingLatency", MetricName: "IndexingLatency", Statistic: "Average"},
+}
+
+var AwsxOpenSearchLatencyCmd = &cobra.Command{
+	Use:   "opensearch_latency_panel",
+	Short: "get search and indexing latency of the domain",
+	Long:  `command to get the average search and indexing latency of the domain in milliseconds`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetOpenSearchLatencyPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting opensearch latency: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetOpenSearchLatencyPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetOpenSearchDomainPanel(cmd, clientAuth, latencySeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxOpenSearchLatencyCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxOpenSearchLatencyCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxOpenSearchLatencyCmd.PersistentFlags().String("query", "", "query")
+	AwsxOpenSearchLatencyCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxOpenSearchLatencyCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxOpenSearchLatencyCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxOpenSearchLatencyCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxOpenSearchLatencyCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxOpenSearchLatencyCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxOpenSearchLatencyCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxOpenSearchLatencyCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxOpenSearchLatencyCmd.PersistentFlags().String("DomainName", "", "opensearch domain name or arn")
+	AwsxOpenSearchLatencyCmd.PersistentFlags().String("ClientId", "", "account id of the domain. looked up when not given")
+	AwsxOpenSearchLatencyCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxOpenSearchLatencyCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxOpenSearchLatencyCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxOpenSearchLatencyCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
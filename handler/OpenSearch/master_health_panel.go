@@ -0,0 +1,77 @@
+package OpenSearch
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+// MasterReachableFromNode is 0 when the data nodes could not reach the master during the period
+var masterHealthSeries = []Metric.Series{
+	{Label: "MasterReachableFromNode", MetricName: "MasterReachableFromNode", Statistic: "Minimum"},
+	{Label: "MasterCPUUtilization", MetricName: "MasterCPUUtilization", Statistic: "Maximum"},
+	{Label: "MasterJVMMemoryPressure", MetricName: "MasterJVMMemoryPressure", Statistic: "Maximum"},
+}
+
+var AwsxOpenSearchMasterHealthCmd = &cobra.Command{
+	Use:   "opensearch_master_health_panel",
+	Short: "get health of the master node of the domain",
+	Long:  `command to get whether the master node was reachable and its cpu and jvm memory pressure`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetOpenSearchMasterHealthPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting opensearch master health: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetOpenSearchMasterHealthPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetOpenSearchDomainPanel(cmd, clientAuth, masterHealthSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxOpenSearchMasterHealthCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxOpenSearchMasterHealthCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxOpenSearchMasterHealthCmd.PersistentFlags().String("query", "", "query")
+	AwsxOpenSearchMasterHealthCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxOpenSearchMasterHealthCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxOpenSearchMasterHealthCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxOpenSearchMasterHealthCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxOpenSearchMasterHealthCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxOpenSearchMasterHealthCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxOpenSearchMasterHealthCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxOpenSearchMasterHealthCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxOpenSearchMasterHealthCmd.PersistentFlags().String("DomainName", "", "opensearch domain name or arn")
+	AwsxOpenSearchMasterHealthCmd.PersistentFlags().String("ClientId", "", "account id of the domain. looked up when not given")
+	AwsxOpenSearchMasterHealthCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxOpenSearchMasterHealthCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxOpenSearchMasterHealthCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxOpenSearchMasterHealthCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
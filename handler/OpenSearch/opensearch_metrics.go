@@ -0,0 +1,58 @@
+package OpenSearch
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+const namespace = "AWS/ES"
+
+// period is the granularity OpenSearch Service publishes its metrics at
+const period = 60
+
+// GetOpenSearchDomainPanel resolves the domain and returns the given domain level series of it.
+func GetOpenSearchDomainPanel(cmd *cobra.Command, clientAuth *model.Auth, series []Metric.Series, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	clientId, domainName, err := GetOpenSearchDomain(cmd, clientAuth)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result, cloudwatchMetricData, err := Metric.GetMetricData(clientAuth, namespace, period, domainDimensions(clientId, domainName), series, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting domain metric data: ", err)
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func domainDimensions(clientId, domainName string) []*cloudwatch.Dimension {
+	return []*cloudwatch.Dimension{
+		{
+			Name:  aws.String("ClientId"),
+			Value: aws.String(clientId),
+		},
+		{
+			Name:  aws.String("DomainName"),
+			Value: aws.String(domainName),
+		},
+	}
+}
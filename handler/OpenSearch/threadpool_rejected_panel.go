@@ -0,0 +1,75 @@
+package OpenSearch
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var threadpoolRejectedSeries = []Metric.Series{
+	{Label: "ThreadpoolWriteRejected", MetricName: "ThreadpoolWriteRejected", Statistic: "Sum"},
+	{Label: "ThreadpoolSearchRejected", MetricName: "ThreadpoolSearchRejected", Statistic: "Sum"},
+}
+
+var AwsxOpenSearchThreadpoolRejectedCmd = &cobra.Command{
+	Use:   "opensearch_threadpool_rejected_panel",
+	Short: "get rejected write and search requests of the domain",
+	Long:  `command to get the number of write and search requests the domain thread pools rejected`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetOpenSearchThreadpoolRejectedPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting opensearch threadpool rejections: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetOpenSearchThreadpoolRejectedPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetOpenSearchDomainPanel(cmd, clientAuth, threadpoolRejectedSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxOpenSearchThreadpoolRejectedCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxOpenSearchThreadpoolRejectedCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxOpenSearchThreadpoolRejectedCmd.PersistentFlags().String("query", "", "query")
+	AwsxOpenSearchThreadpoolRejectedCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxOpenSearchThreadpoolRejectedCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxOpenSearchThreadpoolRejectedCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxOpenSearchThreadpoolRejectedCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxOpenSearchThreadpoolRejectedCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxOpenSearchThreadpoolRejectedCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxOpenSearchThreadpoolRejectedCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxOpenSearchThreadpoolRejectedCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxOpenSearchThreadpoolRejectedCmd.PersistentFlags().String("DomainName", "", "opensearch domain name or arn")
+	AwsxOpenSearchThreadpoolRejectedCmd.PersistentFlags().String("ClientId", "", "account id of the domain. looked up when not given")
+	AwsxOpenSearchThreadpoolRejectedCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxOpenSearchThreadpoolRejectedCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxOpenSearchThreadpoolRejectedCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxOpenSearchThreadpoolRejectedCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -1,6 +1,7 @@
 package RDS
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -8,18 +9,18 @@ import (
 	"time"
 
 	"github.com/Appkube-awsx/awsx-common/authenticate"
-	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/cmdb"
+	"github.com/Appkube-awsx/awsx-common/config"
 	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Alarm"
 	"github.com/olekukonko/tablewriter"
 
-	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/spf13/cobra"
 )
 
-type AlarmNotification struct {
-	Timestamp   time.Time
-	Alert       string
-	Description string
+type AlertsAndNotificationsData struct {
+	Notifications []Alarm.AlarmNotification `json:"notifications"`
+	Timeline      *Alarm.AlarmTimeline      `json:"timeline"`
 }
 
 var RdsAlarmandNotificationcmd = &cobra.Command{
@@ -36,16 +37,16 @@ var RdsAlarmandNotificationcmd = &cobra.Command{
 
 		if authFlag {
 			responseType, _ := cmd.PersistentFlags().GetString("responseType")
-			notifications, err := GetAlertsAndNotificationsPanell(cmd, clientAuth)
+			_, alertsData, err := GetAlertsAndNotificationsPanell(cmd, clientAuth)
 			if err != nil {
 				log.Println("Error getting alerts and notifications:", err)
 				return
 			}
 
 			if responseType == "frame" {
-				fmt.Println(notifications)
+				fmt.Println(alertsData)
 			} else {
-				printTable(notifications)
+				printTable(alertsData.Notifications)
 			}
 		}
 	},
@@ -59,10 +60,31 @@ func handleAuth(cmd *cobra.Command) (bool, *model.Auth, error) {
 	return authFlag, clientAuth, nil
 }
 
-func GetAlertsAndNotificationsPanell(cmd *cobra.Command, clientAuth *model.Auth) ([]AlarmNotification, error) {
+func GetAlertsAndNotificationsPanell(cmd *cobra.Command, clientAuth *model.Auth) (string, *AlertsAndNotificationsData, error) {
+	elementId, _ := cmd.PersistentFlags().GetString("elementId")
+	cmdbApiUrl, _ := cmd.PersistentFlags().GetString("cmdbApiUrl")
+	instanceId, _ := cmd.PersistentFlags().GetString("instanceId")
 	startTimeStr, _ := cmd.PersistentFlags().GetString("startTime")
 	endTimeStr, _ := cmd.PersistentFlags().GetString("endTime")
 
+	if elementId != "" {
+		log.Println("getting cloud-element data from cmdb")
+		apiUrl := cmdbApiUrl
+		if cmdbApiUrl == "" {
+			log.Println("using default cmdb url")
+			apiUrl = config.CmdbUrl
+		}
+		log.Println("cmdb url: " + apiUrl)
+		cmdbData, err := cmdb.GetCloudElementData(apiUrl, elementId)
+		if err != nil {
+			return "", nil, err
+		}
+		instanceId = cmdbData.InstanceId
+	}
+	if instanceId == "" {
+		return "", nil, errors.New("instance id not provided")
+	}
+
 	var startTime, endTime time.Time
 	var err error
 
@@ -70,18 +92,18 @@ func GetAlertsAndNotificationsPanell(cmd *cobra.Command, clientAuth *model.Auth)
 		startTime, err = time.Parse(time.RFC3339, startTimeStr)
 		if err != nil {
 			log.Printf("Error parsing start time: %v", err)
-			return nil, err
+			return "", nil, err
 		}
 	} else {
 		log.Println("Start time not provided. Please provide a start time.")
-		return nil, errors.New("start time not provided")
+		return "", nil, errors.New("start time not provided")
 	}
 
 	if endTimeStr != "" {
 		endTime, err = time.Parse(time.RFC3339, endTimeStr)
 		if err != nil {
 			log.Printf("Error parsing end time: %v", err)
-			return nil, err
+			return "", nil, err
 		}
 	} else {
 		endTime = time.Now()
@@ -89,55 +111,46 @@ func GetAlertsAndNotificationsPanell(cmd *cobra.Command, clientAuth *model.Auth)
 
 	log.Printf("StartTime: %v, EndTime: %v", startTime, endTime)
 
-	// Retrieve CloudWatch alarms
-	alarms, err := GetCloudWatchAlarms(clientAuth, &startTime, &endTime)
+	// Retrieve CloudWatch alarms watching this database instance
+	elementAlarms, err := GetCloudWatchAlarms(clientAuth, instanceId)
 	if err != nil {
 		log.Println("Error getting CloudWatch alarms:", err)
-		return nil, err
+		return "", nil, err
 	}
 
-	// Convert CloudWatch alarms to AlarmNotification struct
-	notifications := make([]AlarmNotification, len(alarms))
-	for i, alarm := range alarms {
-		notifications[i] = AlarmNotification{
-			Timestamp:   *alarm.StateUpdatedTimestamp,
-			Alert:       *alarm.StateReason,
-			Description: *alarm.AlarmDescription,
-		}
+	timeline, err := Alarm.GetAlarmTimeline(clientAuth, elementAlarms.CurrentStates(), &startTime, &endTime, nil)
+	if err != nil {
+		log.Println("Error getting alarm history:", err)
+		return "", nil, err
 	}
 
-	return notifications, nil
-}
-
-func GetCloudWatchAlarms(clientAuth *model.Auth, startTime, endTime *time.Time) ([]*cloudwatch.MetricAlarm, error) {
-	svc := awsclient.GetClient(*clientAuth, awsclient.CLOUDWATCH).(*cloudwatch.CloudWatch)
-
-	// Call DescribeAlarms to get all alarms
-	resp, err := svc.DescribeAlarms(&cloudwatch.DescribeAlarmsInput{})
+	alertsData := &AlertsAndNotificationsData{
+		Notifications: elementAlarms.Notifications(startTime, endTime),
+		Timeline:      timeline,
+	}
+	jsonString, err := json.Marshal(alertsData)
 	if err != nil {
-		log.Println("Error describing alarms:", err)
-		return nil, err
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
 	}
 
-	// Filter alarms based on their last updated time within the specified range
-	filteredAlarms := make([]*cloudwatch.MetricAlarm, 0)
-	for _, alarm := range resp.MetricAlarms {
-		stateUpdatedTime := alarm.StateUpdatedTimestamp
-		if stateUpdatedTime != nil && stateUpdatedTime.After(*startTime) && stateUpdatedTime.Before(*endTime) {
-			filteredAlarms = append(filteredAlarms, alarm)
-		}
-	}
+	return string(jsonString), alertsData, nil
+}
 
-	return filteredAlarms, nil
+func GetCloudWatchAlarms(clientAuth *model.Auth, instanceId string) (*Alarm.ElementAlarms, error) {
+	// Only alarms on this database instance, plus composite alarms built on them
+	return Alarm.GetElementAlarms(clientAuth, Alarm.GetDimensionName("RDS"), instanceId, nil)
 }
 
-func printTable(notifications []AlarmNotification) {
+func printTable(notifications []Alarm.AlarmNotification) {
 	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"Timestamp", "Alert", "Description"})
+	table.SetHeader([]string{"Timestamp", "Alarm", "State", "Alert", "Description"})
 
 	for _, notification := range notifications {
 		table.Append([]string{
 			notification.Timestamp.Format(time.RFC3339),
+			notification.AlarmName,
+			notification.State,
 			notification.Alert,
 			notification.Description,
 		})
@@ -0,0 +1,79 @@
+package RDS
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var auroraBufferCacheHitRatioPanel = AuroraPanel{
+	Series: []Metric.Series{
+		{Label: "BufferCacheHitRatio", MetricName: "BufferCacheHitRatio", Statistic: "Average"},
+	},
+	GroupBy: []string{auroraGroupByRole, auroraGroupByInstance, auroraGroupByCluster},
+	Period:  60,
+}
+
+var AwsxAuroraBufferCacheHitRatioCmd = &cobra.Command{
+	Use:   "aurora_buffer_cache_hit_ratio_panel",
+	Short: "get buffer cache hit ratio of the aurora cluster",
+	Long:  `command to get the percentage of requests of the aurora instances served from the buffer cache`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetAuroraBufferCacheHitRatioPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting aurora buffer cache hit ratio: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetAuroraBufferCacheHitRatioPanel returns the percentage of requests served from the buffer cache.
+func GetAuroraBufferCacheHitRatioPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetAuroraClusterPanel(cmd, clientAuth, auroraBufferCacheHitRatioPanel, cloudWatchClient)
+}
+
+func init() {
+	AwsxAuroraBufferCacheHitRatioCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxAuroraBufferCacheHitRatioCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxAuroraBufferCacheHitRatioCmd.PersistentFlags().String("query", "", "query")
+	AwsxAuroraBufferCacheHitRatioCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxAuroraBufferCacheHitRatioCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxAuroraBufferCacheHitRatioCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxAuroraBufferCacheHitRatioCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxAuroraBufferCacheHitRatioCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxAuroraBufferCacheHitRatioCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxAuroraBufferCacheHitRatioCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxAuroraBufferCacheHitRatioCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxAuroraBufferCacheHitRatioCmd.PersistentFlags().String("DBClusterIdentifier", "", "aurora cluster identifier")
+	AwsxAuroraBufferCacheHitRatioCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxAuroraBufferCacheHitRatioCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxAuroraBufferCacheHitRatioCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxAuroraBufferCacheHitRatioCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+	AwsxAuroraBufferCacheHitRatioCmd.PersistentFlags().String("groupBy", "", "group series by. role/instance/cluster. defaults to role")
+}
@@ -0,0 +1,126 @@
+package RDS
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/cmdb"
+	"github.com/Appkube-awsx/awsx-common/config"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/spf13/cobra"
+)
+
+const (
+	auroraRoleWriter = "WRITER"
+	auroraRoleReader = "READER"
+)
+
+// AuroraClusterMember is an instance of an Aurora cluster with its role.
+type AuroraClusterMember struct {
+	DBInstanceIdentifier string `json:"dbInstanceIdentifier"`
+	// Role is WRITER or READER, as in the Role metric dimension
+	Role          string `json:"role"`
+	PromotionTier int64  `json:"promotionTier"`
+}
+
+type AuroraCluster struct {
+	DBClusterIdentifier string                `json:"dbClusterIdentifier"`
+	Engine              string                `json:"engine"`
+	EngineMode          string                `json:"engineMode"`
+	Members             []AuroraClusterMember `json:"members"`
+}
+
+// GetAuroraClusterIdentifier returns the cluster from the DBClusterIdentifier flag, the CMDB
+// element or the instanceId flag, in that order. The value may also name a member instance,
+// see GetAuroraCluster.
+func GetAuroraClusterIdentifier(cmd *cobra.Command) (string, error) {
+	clusterIdentifier, _ := cmd.PersistentFlags().GetString("DBClusterIdentifier")
+	elementId, _ := cmd.PersistentFlags().GetString("elementId")
+	cmdbApiUrl, _ := cmd.PersistentFlags().GetString("cmdbApiUrl")
+	instanceId, _ := cmd.PersistentFlags().GetString("instanceId")
+
+	if clusterIdentifier != "" {
+		return clusterIdentifier, nil
+	}
+
+	if elementId != "" {
+		log.Println("getting cloud-element data from cmdb")
+		apiUrl := cmdbApiUrl
+		if cmdbApiUrl == "" {
+			log.Println("using default cmdb url")
+			apiUrl = config.CmdbUrl
+		}
+		log.Println("cmdb url: " + apiUrl)
+		cmdbData, err := cmdb.GetCloudElementData(apiUrl, elementId)
+		if err != nil {
+			return "", err
+		}
+		instanceId = cmdbData.InstanceId
+	}
+
+	if instanceId == "" {
+		return "", errors.New("cluster identifier not provided. provide DBClusterIdentifier or elementId")
+	}
+	return instanceId, nil
+}
+
+// GetAuroraCluster describes the Aurora cluster and its writer and readers. An instance
+// identifier is resolved to the cluster the instance belongs to, so that an instance
+// element gets the panels of its cluster.
+func GetAuroraCluster(clientAuth *model.Auth, identifier string) (*AuroraCluster, error) {
+	rdsClient := awsclient.GetClient(*clientAuth, awsclient.RDS_CLIENT).(*rds.RDS)
+
+	output, err := rdsClient.DescribeDBClusters(&rds.DescribeDBClustersInput{
+		DBClusterIdentifier: aws.String(identifier),
+	})
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == rds.ErrCodeDBClusterNotFoundFault {
+		instances, err := rdsClient.DescribeDBInstances(&rds.DescribeDBInstancesInput{
+			DBInstanceIdentifier: aws.String(identifier),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(instances.DBInstances) == 0 || aws.StringValue(instances.DBInstances[0].DBClusterIdentifier) == "" {
+			return nil, fmt.Errorf("%s is neither an Aurora cluster nor a member of one", identifier)
+		}
+		output, err = rdsClient.DescribeDBClusters(&rds.DescribeDBClustersInput{
+			DBClusterIdentifier: instances.DBInstances[0].DBClusterIdentifier,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(output.DBClusters) == 0 {
+		return nil, fmt.Errorf("cluster %s not found", identifier)
+	}
+
+	dbCluster := output.DBClusters[0]
+	if !strings.HasPrefix(aws.StringValue(dbCluster.Engine), "aurora") {
+		return nil, fmt.Errorf("cluster %s is not an Aurora cluster", aws.StringValue(dbCluster.DBClusterIdentifier))
+	}
+
+	cluster := &AuroraCluster{
+		DBClusterIdentifier: aws.StringValue(dbCluster.DBClusterIdentifier),
+		Engine:              aws.StringValue(dbCluster.Engine),
+		EngineMode:          aws.StringValue(dbCluster.EngineMode),
+		Members:             []AuroraClusterMember{},
+	}
+	for _, member := range dbCluster.DBClusterMembers {
+		role := auroraRoleReader
+		if aws.BoolValue(member.IsClusterWriter) {
+			role = auroraRoleWriter
+		}
+		cluster.Members = append(cluster.Members, AuroraClusterMember{
+			DBInstanceIdentifier: aws.StringValue(member.DBInstanceIdentifier),
+			Role:                 role,
+			PromotionTier:        aws.Int64Value(member.PromotionTier),
+		})
+	}
+	return cluster, nil
+}
@@ -0,0 +1,167 @@
+package RDS
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+type AuroraMemberDetails struct {
+	AuroraClusterMember
+	InstanceClass    string `json:"instanceClass"`
+	AvailabilityZone string `json:"availabilityZone"`
+	Status           string `json:"status"`
+}
+
+var AwsxAuroraClusterMembersCmd = &cobra.Command{
+	Use:   "aurora_cluster_members_panel",
+	Short: "get the writer and readers of the aurora cluster",
+	Long:  `command to get the writer and readers of the aurora cluster with their instance class, availability zone and status`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, table, err := GetAuroraClusterMembersPanel(cmd, clientAuth)
+			if err != nil {
+				log.Println("Error getting aurora cluster members: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(table)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetAuroraClusterMembersPanel returns the writer and readers of the cluster with their
+// instance class, availability zone and status.
+func GetAuroraClusterMembersPanel(cmd *cobra.Command, clientAuth *model.Auth) (string, string, error) {
+	identifier, err := GetAuroraClusterIdentifier(cmd)
+	if err != nil {
+		return "", "", err
+	}
+
+	cluster, err := GetAuroraCluster(clientAuth, identifier)
+	if err != nil {
+		log.Println("Error in getting aurora cluster: ", err)
+		return "", "", err
+	}
+
+	members, err := GetAuroraMemberDetails(clientAuth, cluster)
+	if err != nil {
+		log.Println("Error in getting aurora cluster members: ", err)
+		return "", "", err
+	}
+
+	jsonString, err := json.Marshal(members)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", "", err
+	}
+	return string(jsonString), printAuroraMembersTable(members), nil
+}
+
+// GetAuroraMemberDetails adds the instance details of every member of the cluster, writer first.
+func GetAuroraMemberDetails(clientAuth *model.Auth, cluster *AuroraCluster) ([]AuroraMemberDetails, error) {
+	rdsClient := awsclient.GetClient(*clientAuth, awsclient.RDS_CLIENT).(*rds.RDS)
+
+	instances := map[string]*rds.DBInstance{}
+	input := &rds.DescribeDBInstancesInput{
+		Filters: []*rds.Filter{
+			{
+				Name:   aws.String("db-cluster-id"),
+				Values: aws.StringSlice([]string{cluster.DBClusterIdentifier}),
+			},
+		},
+	}
+	err := rdsClient.DescribeDBInstancesPages(input, func(page *rds.DescribeDBInstancesOutput, lastPage bool) bool {
+		for _, instance := range page.DBInstances {
+			instances[aws.StringValue(instance.DBInstanceIdentifier)] = instance
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	members := []AuroraMemberDetails{}
+	for _, role := range []string{auroraRoleWriter, auroraRoleReader} {
+		for _, member := range cluster.Members {
+			if member.Role != role {
+				continue
+			}
+			details := AuroraMemberDetails{AuroraClusterMember: member}
+			if instance, ok := instances[member.DBInstanceIdentifier]; ok {
+				details.InstanceClass = aws.StringValue(instance.DBInstanceClass)
+				details.AvailabilityZone = aws.StringValue(instance.AvailabilityZone)
+				details.Status = aws.StringValue(instance.DBInstanceStatus)
+			}
+			members = append(members, details)
+		}
+	}
+	return members, nil
+}
+
+func printAuroraMembersTable(members []AuroraMemberDetails) string {
+	var buffer bytes.Buffer
+	if len(members) == 0 {
+		buffer.WriteString("No cluster members found.")
+		return buffer.String()
+	}
+
+	table := tablewriter.NewWriter(&buffer)
+	table.SetHeader([]string{"Instance", "Role", "Promotion Tier", "Instance Class", "Availability Zone", "Status"})
+	for _, member := range members {
+		table.Append([]string{
+			member.DBInstanceIdentifier,
+			member.Role,
+			strconv.FormatInt(member.PromotionTier, 10),
+			member.InstanceClass,
+			member.AvailabilityZone,
+			member.Status,
+		})
+	}
+	table.Render()
+	return buffer.String()
+}
+
+func init() {
+	AwsxAuroraClusterMembersCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxAuroraClusterMembersCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxAuroraClusterMembersCmd.PersistentFlags().String("query", "", "query")
+	AwsxAuroraClusterMembersCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxAuroraClusterMembersCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxAuroraClusterMembersCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxAuroraClusterMembersCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxAuroraClusterMembersCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxAuroraClusterMembersCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxAuroraClusterMembersCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxAuroraClusterMembersCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxAuroraClusterMembersCmd.PersistentFlags().String("DBClusterIdentifier", "", "aurora cluster identifier")
+	AwsxAuroraClusterMembersCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxAuroraClusterMembersCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
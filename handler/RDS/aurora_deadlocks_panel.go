@@ -0,0 +1,79 @@
+package RDS
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var auroraDeadlocksPanel = AuroraPanel{
+	Series: []Metric.Series{
+		{Label: "Deadlocks", MetricName: "Deadlocks", Statistic: "Average"},
+	},
+	GroupBy: []string{auroraGroupByRole, auroraGroupByInstance, auroraGroupByCluster},
+	Period:  60,
+}
+
+var AwsxAuroraDeadlocksCmd = &cobra.Command{
+	Use:   "aurora_deadlocks_panel",
+	Short: "get deadlocks of the aurora cluster",
+	Long:  `command to get the average number of deadlocks per second of the aurora instances`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetAuroraDeadlocksPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting aurora deadlocks: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetAuroraDeadlocksPanel returns the average number of deadlocks per second.
+func GetAuroraDeadlocksPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetAuroraClusterPanel(cmd, clientAuth, auroraDeadlocksPanel, cloudWatchClient)
+}
+
+func init() {
+	AwsxAuroraDeadlocksCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxAuroraDeadlocksCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxAuroraDeadlocksCmd.PersistentFlags().String("query", "", "query")
+	AwsxAuroraDeadlocksCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxAuroraDeadlocksCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxAuroraDeadlocksCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxAuroraDeadlocksCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxAuroraDeadlocksCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxAuroraDeadlocksCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxAuroraDeadlocksCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxAuroraDeadlocksCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxAuroraDeadlocksCmd.PersistentFlags().String("DBClusterIdentifier", "", "aurora cluster identifier")
+	AwsxAuroraDeadlocksCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxAuroraDeadlocksCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxAuroraDeadlocksCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxAuroraDeadlocksCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+	AwsxAuroraDeadlocksCmd.PersistentFlags().String("groupBy", "", "group series by. role/instance/cluster. defaults to role")
+}
@@ -0,0 +1,115 @@
+package RDS
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+// groupBy values of the Aurora panels
+const (
+	auroraGroupByCluster  = "cluster"
+	auroraGroupByRole     = "role"
+	auroraGroupByInstance = "instance"
+)
+
+// AuroraPanel describes an Aurora panel. GroupBy lists the groupBy values the metrics are
+// published at, the first being the default.
+type AuroraPanel struct {
+	Series  []Metric.Series
+	GroupBy []string
+	Period  int64
+}
+
+// GetAuroraClusterPanel resolves the cluster and returns the series of the panel grouped
+// by the groupBy flag: the whole cluster, its writer and readers by role, or every member
+// instance. The output is keyed by cluster identifier, role or instance identifier.
+func GetAuroraClusterPanel(cmd *cobra.Command, clientAuth *model.Auth, panel AuroraPanel, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	groupBy, _ := cmd.PersistentFlags().GetString("groupBy")
+	if groupBy == "" {
+		groupBy = panel.GroupBy[0]
+	}
+	supported := false
+	for _, g := range panel.GroupBy {
+		if g == groupBy {
+			supported = true
+		}
+	}
+	if !supported {
+		return "", nil, fmt.Errorf("unsupported groupBy %q. use %s", groupBy, strings.Join(panel.GroupBy, ", "))
+	}
+
+	identifier, err := GetAuroraClusterIdentifier(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	cluster, err := GetAuroraCluster(clientAuth, identifier)
+	if err != nil {
+		log.Println("Error in getting aurora cluster: ", err)
+		return "", nil, err
+	}
+
+	result, cloudwatchMetricData, err := Metric.GetEntityMetricData(clientAuth, "AWS/RDS", panel.Period, auroraGroupDimensions(cluster, groupBy), panel.Series, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting aurora metric data: ", err)
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+// auroraGroupDimensions returns the dimensions of every group of the cluster for groupBy.
+// The role groups use the DBClusterIdentifier and Role dimensions Aurora aggregates its
+// instance metrics under; a role without members is left out.
+func auroraGroupDimensions(cluster *AuroraCluster, groupBy string) map[string][]*cloudwatch.Dimension {
+	clusterDimension := &cloudwatch.Dimension{
+		Name:  aws.String("DBClusterIdentifier"),
+		Value: aws.String(cluster.DBClusterIdentifier),
+	}
+
+	groups := map[string][]*cloudwatch.Dimension{}
+	switch groupBy {
+	case auroraGroupByRole:
+		for _, member := range cluster.Members {
+			groups[member.Role] = []*cloudwatch.Dimension{
+				clusterDimension,
+				{
+					Name:  aws.String("Role"),
+					Value: aws.String(member.Role),
+				},
+			}
+		}
+	case auroraGroupByInstance:
+		for _, member := range cluster.Members {
+			groups[member.DBInstanceIdentifier] = []*cloudwatch.Dimension{
+				{
+					Name:  aws.String("DBInstanceIdentifier"),
+					Value: aws.String(member.DBInstanceIdentifier),
+				},
+			}
+		}
+	default:
+		groups[cluster.DBClusterIdentifier] = []*cloudwatch.Dimension{clusterDimension}
+	}
+	return groups
+}
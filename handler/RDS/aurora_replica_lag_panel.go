@@ -0,0 +1,79 @@
+package RDS
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var auroraReplicaLagPanel = AuroraPanel{
+	Series: []Metric.Series{
+		{Label: "AuroraReplicaLag", MetricName: "AuroraReplicaLag", Statistic: "Maximum"},
+	},
+	GroupBy: []string{auroraGroupByInstance, auroraGroupByRole},
+	Period:  60,
+}
+
+var AwsxAuroraReplicaLagCmd = &cobra.Command{
+	Use:   "aurora_replica_lag_panel",
+	Short: "get replica lag of the aurora readers",
+	Long:  `command to get the lag of the aurora readers behind the writer`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetAuroraReplicaLagPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting aurora replica lag: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetAuroraReplicaLagPanel returns the lag of every reader behind the writer. The writer reports no data.
+func GetAuroraReplicaLagPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetAuroraClusterPanel(cmd, clientAuth, auroraReplicaLagPanel, cloudWatchClient)
+}
+
+func init() {
+	AwsxAuroraReplicaLagCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxAuroraReplicaLagCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxAuroraReplicaLagCmd.PersistentFlags().String("query", "", "query")
+	AwsxAuroraReplicaLagCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxAuroraReplicaLagCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxAuroraReplicaLagCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxAuroraReplicaLagCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxAuroraReplicaLagCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxAuroraReplicaLagCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxAuroraReplicaLagCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxAuroraReplicaLagCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxAuroraReplicaLagCmd.PersistentFlags().String("DBClusterIdentifier", "", "aurora cluster identifier")
+	AwsxAuroraReplicaLagCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxAuroraReplicaLagCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxAuroraReplicaLagCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxAuroraReplicaLagCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+	AwsxAuroraReplicaLagCmd.PersistentFlags().String("groupBy", "", "group series by. instance/role. defaults to instance")
+}
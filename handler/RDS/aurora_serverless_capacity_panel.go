@@ -0,0 +1,80 @@
+package RDS
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var auroraServerlessCapacityPanel = AuroraPanel{
+	Series: []Metric.Series{
+		{Label: "ServerlessDatabaseCapacity", MetricName: "ServerlessDatabaseCapacity", Statistic: "Average"},
+		{Label: "ACUUtilization", MetricName: "ACUUtilization", Statistic: "Average"},
+	},
+	GroupBy: []string{auroraGroupByRole, auroraGroupByInstance, auroraGroupByCluster},
+	Period:  60,
+}
+
+var AwsxAuroraServerlessCapacityCmd = &cobra.Command{
+	Use:   "aurora_serverless_capacity_panel",
+	Short: "get serverless capacity of the aurora cluster",
+	Long:  `command to get the capacity in ACUs and the ACU utilization of an aurora serverless cluster`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetAuroraServerlessCapacityPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting aurora serverless capacity: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetAuroraServerlessCapacityPanel returns the capacity in ACUs and the utilization of the maximum capacity of a Serverless v2 cluster.
+func GetAuroraServerlessCapacityPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetAuroraClusterPanel(cmd, clientAuth, auroraServerlessCapacityPanel, cloudWatchClient)
+}
+
+func init() {
+	AwsxAuroraServerlessCapacityCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxAuroraServerlessCapacityCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxAuroraServerlessCapacityCmd.PersistentFlags().String("query", "", "query")
+	AwsxAuroraServerlessCapacityCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxAuroraServerlessCapacityCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxAuroraServerlessCapacityCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxAuroraServerlessCapacityCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxAuroraServerlessCapacityCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxAuroraServerlessCapacityCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxAuroraServerlessCapacityCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxAuroraServerlessCapacityCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxAuroraServerlessCapacityCmd.PersistentFlags().String("DBClusterIdentifier", "", "aurora cluster identifier")
+	AwsxAuroraServerlessCapacityCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxAuroraServerlessCapacityCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxAuroraServerlessCapacityCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxAuroraServerlessCapacityCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+	AwsxAuroraServerlessCapacityCmd.PersistentFlags().String("groupBy", "", "group series by. role/instance/cluster. defaults to role")
+}
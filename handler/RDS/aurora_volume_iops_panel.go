@@ -0,0 +1,80 @@
+package RDS
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var auroraVolumeIopsPanel = AuroraPanel{
+	Series: []Metric.Series{
+		{Label: "VolumeReadIOPs", MetricName: "VolumeReadIOPs", Statistic: "Sum"},
+		{Label: "VolumeWriteIOPs", MetricName: "VolumeWriteIOPs", Statistic: "Sum"},
+	},
+	GroupBy: []string{auroraGroupByCluster},
+	Period:  300,
+}
+
+var AwsxAuroraVolumeIopsCmd = &cobra.Command{
+	Use:   "aurora_volume_iops_panel",
+	Short: "get volume read and write IOs of the aurora cluster",
+	Long:  `command to get the billed read and write IO operations of the aurora cluster volume`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetAuroraVolumeIopsPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting aurora volume iops: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetAuroraVolumeIopsPanel returns the billed read and write IO operations of the cluster volume per 5 minute interval.
+func GetAuroraVolumeIopsPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetAuroraClusterPanel(cmd, clientAuth, auroraVolumeIopsPanel, cloudWatchClient)
+}
+
+func init() {
+	AwsxAuroraVolumeIopsCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxAuroraVolumeIopsCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxAuroraVolumeIopsCmd.PersistentFlags().String("query", "", "query")
+	AwsxAuroraVolumeIopsCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxAuroraVolumeIopsCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxAuroraVolumeIopsCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxAuroraVolumeIopsCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxAuroraVolumeIopsCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxAuroraVolumeIopsCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxAuroraVolumeIopsCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxAuroraVolumeIopsCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxAuroraVolumeIopsCmd.PersistentFlags().String("DBClusterIdentifier", "", "aurora cluster identifier")
+	AwsxAuroraVolumeIopsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxAuroraVolumeIopsCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxAuroraVolumeIopsCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxAuroraVolumeIopsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+	AwsxAuroraVolumeIopsCmd.PersistentFlags().String("groupBy", "", "group series by. cluster. defaults to cluster")
+}
@@ -0,0 +1,79 @@
+package RDS
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var auroraVolumePanel = AuroraPanel{
+	Series: []Metric.Series{
+		{Label: "VolumeBytesUsed", MetricName: "VolumeBytesUsed", Statistic: "Average"},
+	},
+	GroupBy: []string{auroraGroupByCluster},
+	Period:  300,
+}
+
+var AwsxAuroraVolumeCmd = &cobra.Command{
+	Use:   "aurora_volume_panel",
+	Short: "get volume bytes used of the aurora cluster",
+	Long:  `command to get the storage used by the aurora cluster volume`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetAuroraVolumePanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting aurora volume: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetAuroraVolumePanel returns the storage used by the cluster volume, which is shared by all instances.
+func GetAuroraVolumePanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetAuroraClusterPanel(cmd, clientAuth, auroraVolumePanel, cloudWatchClient)
+}
+
+func init() {
+	AwsxAuroraVolumeCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxAuroraVolumeCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxAuroraVolumeCmd.PersistentFlags().String("query", "", "query")
+	AwsxAuroraVolumeCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxAuroraVolumeCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxAuroraVolumeCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxAuroraVolumeCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxAuroraVolumeCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxAuroraVolumeCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxAuroraVolumeCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxAuroraVolumeCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxAuroraVolumeCmd.PersistentFlags().String("DBClusterIdentifier", "", "aurora cluster identifier")
+	AwsxAuroraVolumeCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxAuroraVolumeCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxAuroraVolumeCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxAuroraVolumeCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+	AwsxAuroraVolumeCmd.PersistentFlags().String("groupBy", "", "group series by. cluster. defaults to cluster")
+}
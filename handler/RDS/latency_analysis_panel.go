@@ -8,10 +8,10 @@ import (
 	"time"
 
 	"github.com/Appkube-awsx/awsx-common/authenticate"
-	"github.com/Appkube-awsx/awsx-common/awsclient"
 	"github.com/Appkube-awsx/awsx-common/config"
 	"github.com/Appkube-awsx/awsx-common/model"
-	"github.com/aws/aws-sdk-go/aws"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Statistic"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/spf13/cobra"
 )
@@ -62,6 +62,11 @@ func GetRDSLatencyAnalysisData(cmd *cobra.Command, clientAuth *model.Auth, cloud
     startTimeStr, _ := cmd.PersistentFlags().GetString("startTime")
     endTimeStr, _ := cmd.PersistentFlags().GetString("endTime")
 
+    statistics, err := Statistic.GetStatistics(cmd)
+    if err != nil {
+        return "", nil, err
+    }
+
     if elementId != "" {
         log.Println("getting cloud-element data from cmdb")
         apiUrl := cmdbApiUrl
@@ -100,13 +105,31 @@ func GetRDSLatencyAnalysisData(cmd *cobra.Command, clientAuth *model.Auth, cloud
 
     log.Printf("StartTime: %v, EndTime: %v", startTime, endTime)
 
-    rawLatencyData, err := GetMetricDatas(clientAuth, elementType, startTime, endTime, cloudWatchClient)
+    if statistics != nil {
+        rawLatencyData, cloudwatchMetricData, err := GetMetricDatas(clientAuth, elementType, startTime, endTime, statistics, cloudWatchClient)
+        if err != nil {
+            log.Println("Error in getting latency data: ", err)
+            return "", nil, err
+        }
+        results := map[string][]TimeSeriesData{}
+        for statistic, points := range rawLatencyData {
+            results[statistic] = processRawLatencyData(points)
+        }
+        latencyJSON, err := json.Marshal(results)
+        if err != nil {
+            log.Println("Error in marshalling latency data to JSON: ", err)
+            return "", nil, err
+        }
+        return string(latencyJSON), cloudwatchMetricData, nil
+    }
+
+    rawLatencyData, _, err := GetMetricDatas(clientAuth, elementType, startTime, endTime, []string{"Average"}, cloudWatchClient)
     if err != nil {
         log.Println("Error in getting latency data: ", err)
         return "", nil, err
     }
 
-    latencyResult := processRawLatencyData(rawLatencyData)
+    latencyResult := processRawLatencyData(rawLatencyData["Average"])
 
     // Create a new GetMetricDataOutput instance
     output := &cloudwatch.GetMetricDataOutput{
@@ -139,64 +162,31 @@ func GetRDSLatencyAnalysisData(cmd *cobra.Command, clientAuth *model.Auth, cloud
 }
 
 
-func GetMetricDatas(clientAuth *model.Auth, elementType string, startTime, endTime *time.Time, cloudWatchClient *cloudwatch.CloudWatch) (*cloudwatch.GetMetricDataOutput, error) {
+// GetMetricDatas returns ReadLatency plus WriteLatency for every statistic, keyed by
+// statistic, in a single request.
+func GetMetricDatas(clientAuth *model.Auth, elementType string, startTime, endTime *time.Time, statistics []string, cloudWatchClient *cloudwatch.CloudWatch) (map[string][]Metric.DataPoint, map[string]*cloudwatch.GetMetricDataOutput, error) {
 	log.Printf("Getting metric data for elementType %s in namespace AWS/RDS from %v to %v", elementType, startTime, endTime)
 
-	input := &cloudwatch.GetMetricDataInput{
-		EndTime:   endTime,
-		StartTime: startTime,
-		MetricDataQueries: []*cloudwatch.MetricDataQuery{
-			{
-				Id: aws.String("m1"),
-				MetricStat: &cloudwatch.MetricStat{
-					Metric: &cloudwatch.Metric{
-						MetricName: aws.String("ReadLatency"),
-						Namespace:  aws.String("AWS/RDS"),
-					},
-					Period: aws.Int64(60),
-					Stat:   aws.String("Average"),
-				},
-			},
-			{
-				Id: aws.String("m2"),
-				MetricStat: &cloudwatch.MetricStat{
-					Metric: &cloudwatch.Metric{
-						MetricName: aws.String("WriteLatency"),
-						Namespace:  aws.String("AWS/RDS"),
-					},
-					Period: aws.Int64(60),
-					Stat:   aws.String("Average"),
-				},
+	var series []Metric.Series
+	for _, statistic := range statistics {
+		series = append(series, Metric.Series{
+			Label:      statistic,
+			Expression: "{0} + {1}",
+			Metrics: []Metric.MetricStat{
+				{MetricName: "ReadLatency", Statistic: statistic},
+				{MetricName: "WriteLatency", Statistic: statistic},
 			},
-		},
-	}
-	if cloudWatchClient == nil {
-		cloudWatchClient = awsclient.GetClient(*clientAuth, awsclient.CLOUDWATCH).(*cloudwatch.CloudWatch)
-	}
-
-	result, err := cloudWatchClient.GetMetricData(input)
-	if err != nil {
-		return nil, err
+		})
 	}
-	// fmt.Println("hekllo", result)
-	return result, nil
+	return Metric.GetMetricData(clientAuth, "AWS/RDS", 60, nil, series, startTime, endTime, cloudWatchClient)
 }
-func processRawLatencyData(result *cloudwatch.GetMetricDataOutput) []TimeSeriesData {
-	var processedData []TimeSeriesData
-
-	// Assuming both read and write metrics have the same number of data points
-	for i := range result.MetricDataResults[0].Timestamps {
-		readLatency := *result.MetricDataResults[0].Values[i]
-		writeLatency := *result.MetricDataResults[1].Values[i]
-
-		timestamp := *result.MetricDataResults[0].Timestamps[i]
-
-		// Calculate combined latency (sum of read and write latencies)
-		totalLatency := readLatency + writeLatency
 
+func processRawLatencyData(points []Metric.DataPoint) []TimeSeriesData {
+	var processedData []TimeSeriesData
+	for _, point := range points {
 		processedData = append(processedData, TimeSeriesData{
-			Timestamp: timestamp,
-			Latency:   totalLatency,
+			Timestamp: point.Timestamp,
+			Latency:   point.Value,
 		})
 	}
 
@@ -225,5 +215,6 @@ func init() {
 	AwsxRDSLatencyAnalysisCmd.PersistentFlags().String("startTime", "", "start time")
 	AwsxRDSLatencyAnalysisCmd.PersistentFlags().String("endTime", "", "endcl time")
 	AwsxRDSLatencyAnalysisCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+	AwsxRDSLatencyAnalysisCmd.PersistentFlags().String("stat", "", "comma separated statistics, e.g. p50,p90,p99,TM90,IQM")
 }
 
@@ -0,0 +1,67 @@
+package RDS
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Alarm"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var AwsxRDSMeanTimeToRecoveryCmd = &cobra.Command{
+	Use:   "mean_time_to_recovery_panel",
+	Short: "get mean time to recovery data",
+	Long:  `command to get mean time to recovery data`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, recoveryStats, err := GetRDSMeanTimeToRecoveryPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting mean time to recovery: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(recoveryStats)
+			} else {
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetRDSMeanTimeToRecoveryPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, *Alarm.RecoveryStats, error) {
+	return Alarm.GetMeanTimeToRecoveryPanel(cmd, clientAuth, "RDS", nil, cloudWatchClient)
+}
+
+func init() {
+	AwsxRDSMeanTimeToRecoveryCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxRDSMeanTimeToRecoveryCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxRDSMeanTimeToRecoveryCmd.PersistentFlags().String("query", "", "query")
+	AwsxRDSMeanTimeToRecoveryCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxRDSMeanTimeToRecoveryCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxRDSMeanTimeToRecoveryCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxRDSMeanTimeToRecoveryCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxRDSMeanTimeToRecoveryCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxRDSMeanTimeToRecoveryCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxRDSMeanTimeToRecoveryCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxRDSMeanTimeToRecoveryCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxRDSMeanTimeToRecoveryCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxRDSMeanTimeToRecoveryCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxRDSMeanTimeToRecoveryCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxRDSMeanTimeToRecoveryCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,118 @@
+package Route53
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var checkerLatencySeries = []Metric.Series{
+	{Label: "ConnectionTime", MetricName: "ConnectionTime", Statistic: "Average"},
+	{Label: "TimeToFirstByte", MetricName: "TimeToFirstByte", Statistic: "Average"},
+}
+
+var AwsxRoute53CheckerLatencyCmd = &cobra.Command{
+	Use:   "route53_checker_latency_panel",
+	Short: "get health check latency per checker region",
+	Long:  `command to get the connection time and time to first byte of the health check endpoint from every checker region`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetRoute53CheckerLatencyPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting route53 checker latency: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetRoute53CheckerLatencyPanel returns the connection time and time to first byte in
+// milliseconds as seen from every checker region, keyed by region. The regions are found
+// with ListMetrics. TimeToFirstByte is only published for HTTP and HTTPS health checks.
+func GetRoute53CheckerLatencyPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	healthCheckId, err := GetHealthCheckId(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	cloudWatchClient = metricsClient(clientAuth, cloudWatchClient)
+
+	metrics, err := Metric.ListMetrics(cloudWatchClient, namespace, "ConnectionTime", []*cloudwatch.DimensionFilter{
+		{Name: aws.String("HealthCheckId"), Value: aws.String(healthCheckId)},
+		{Name: aws.String("Region")},
+	})
+	if err != nil {
+		log.Println("Error in listing checker region metrics: ", err)
+		return "", nil, err
+	}
+
+	regionDimensions := map[string][]*cloudwatch.Dimension{}
+	for _, metric := range metrics {
+		regionDimensions[Metric.DimensionValue(metric.Dimensions, "Region")] = metric.Dimensions
+	}
+
+	result, cloudwatchMetricData, err := Metric.GetEntityMetricData(clientAuth, namespace, period, regionDimensions, checkerLatencySeries, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting checker region metric data: ", err)
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func init() {
+	AwsxRoute53CheckerLatencyCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxRoute53CheckerLatencyCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxRoute53CheckerLatencyCmd.PersistentFlags().String("query", "", "query")
+	AwsxRoute53CheckerLatencyCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxRoute53CheckerLatencyCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxRoute53CheckerLatencyCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxRoute53CheckerLatencyCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxRoute53CheckerLatencyCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxRoute53CheckerLatencyCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxRoute53CheckerLatencyCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxRoute53CheckerLatencyCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxRoute53CheckerLatencyCmd.PersistentFlags().String("HealthCheckId", "", "route 53 health check id")
+	AwsxRoute53CheckerLatencyCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxRoute53CheckerLatencyCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxRoute53CheckerLatencyCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxRoute53CheckerLatencyCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
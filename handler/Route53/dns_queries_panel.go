@@ -0,0 +1,99 @@
+package Route53
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var dnsQueriesSeries = []Metric.Series{
+	{Label: "DNSQueries", MetricName: "DNSQueries", Statistic: "Sum"},
+}
+
+var AwsxRoute53DNSQueriesCmd = &cobra.Command{
+	Use:   "route53_dns_queries_panel",
+	Short: "get dns query volume of the hosted zone",
+	Long:  `command to get the number of dns queries answered for the hosted zone`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetRoute53DNSQueriesPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting route53 dns queries: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetRoute53DNSQueriesPanel returns the number of DNS queries Route 53 answered for the
+// hosted zone. The metric is only published for public hosted zones.
+func GetRoute53DNSQueriesPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	hostedZoneId, err := GetHostedZoneId(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, time.Hour)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result, cloudwatchMetricData, err := Metric.GetMetricData(clientAuth, namespace, period, Metric.Dimensions("HostedZoneId", hostedZoneId), dnsQueriesSeries, startTime, endTime, metricsClient(clientAuth, cloudWatchClient))
+	if err != nil {
+		log.Println("Error in getting hosted zone metric data: ", err)
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func init() {
+	AwsxRoute53DNSQueriesCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxRoute53DNSQueriesCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxRoute53DNSQueriesCmd.PersistentFlags().String("query", "", "query")
+	AwsxRoute53DNSQueriesCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxRoute53DNSQueriesCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxRoute53DNSQueriesCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxRoute53DNSQueriesCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxRoute53DNSQueriesCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxRoute53DNSQueriesCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxRoute53DNSQueriesCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxRoute53DNSQueriesCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxRoute53DNSQueriesCmd.PersistentFlags().String("HostedZoneId", "", "route 53 hosted zone id")
+	AwsxRoute53DNSQueriesCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxRoute53DNSQueriesCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxRoute53DNSQueriesCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxRoute53DNSQueriesCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
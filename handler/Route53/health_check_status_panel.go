@@ -0,0 +1,74 @@
+package Route53
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var healthCheckStatusSeries = []Metric.Series{
+	{Label: "HealthCheckStatus", MetricName: "HealthCheckStatus", Statistic: "Minimum"},
+	{Label: "HealthCheckPercentageHealthy", MetricName: "HealthCheckPercentageHealthy", Statistic: "Average"},
+}
+
+var AwsxRoute53HealthCheckStatusCmd = &cobra.Command{
+	Use:   "route53_health_check_status_panel",
+	Short: "get status of the health check",
+	Long:  `command to get whether the health check endpoint was healthy and the percent of checkers that found it healthy`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetRoute53HealthCheckStatusPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting route53 health check status: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetRoute53HealthCheckStatusPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetRoute53HealthCheckPanel(cmd, clientAuth, healthCheckStatusSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxRoute53HealthCheckStatusCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxRoute53HealthCheckStatusCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxRoute53HealthCheckStatusCmd.PersistentFlags().String("query", "", "query")
+	AwsxRoute53HealthCheckStatusCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxRoute53HealthCheckStatusCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxRoute53HealthCheckStatusCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxRoute53HealthCheckStatusCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxRoute53HealthCheckStatusCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxRoute53HealthCheckStatusCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxRoute53HealthCheckStatusCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxRoute53HealthCheckStatusCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxRoute53HealthCheckStatusCmd.PersistentFlags().String("HealthCheckId", "", "route 53 health check id")
+	AwsxRoute53HealthCheckStatusCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxRoute53HealthCheckStatusCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxRoute53HealthCheckStatusCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxRoute53HealthCheckStatusCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
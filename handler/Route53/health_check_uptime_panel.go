@@ -0,0 +1,124 @@
+package Route53
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+// HealthCheckUptime is the availability of the health check endpoint over the window as
+// seen from outside. Periods without data, e.g. before the health check existed, are left
+// out rather than counted as down.
+type HealthCheckUptime struct {
+	StartTime        time.Time `json:"startTime"`
+	EndTime          time.Time `json:"endTime"`
+	Periods          int       `json:"periods"`
+	HealthyPeriods   int       `json:"healthyPeriods"`
+	UptimePercentage float64   `json:"uptimePercentage"`
+}
+
+var healthCheckUptimeSeries = []Metric.Series{
+	{Label: "HealthCheckStatus", MetricName: "HealthCheckStatus", Statistic: "Minimum"},
+}
+
+var AwsxRoute53HealthCheckUptimeCmd = &cobra.Command{
+	Use:   "route53_health_check_uptime_panel",
+	Short: "get uptime of the health check endpoint",
+	Long:  `command to get the percent of the window in which the health check found its endpoint healthy`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetRoute53HealthCheckUptimePanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting route53 health check uptime: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetRoute53HealthCheckUptimePanel returns the percent of minutes in which the health check
+// found the endpoint healthy. Without startTime the last day is used.
+func GetRoute53HealthCheckUptimePanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	healthCheckId, err := GetHealthCheckId(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 24*time.Hour)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result, cloudwatchMetricData, err := Metric.GetMetricData(clientAuth, namespace, period, healthCheckDimensions(healthCheckId), healthCheckUptimeSeries, startTime, endTime, metricsClient(clientAuth, cloudWatchClient))
+	if err != nil {
+		log.Println("Error in getting health check metric data: ", err)
+		return "", nil, err
+	}
+
+	uptime := HealthCheckUptime{
+		StartTime: *startTime,
+		EndTime:   *endTime,
+	}
+	for _, point := range result["HealthCheckStatus"] {
+		uptime.Periods++
+		if point.Value >= 1 {
+			uptime.HealthyPeriods++
+		}
+	}
+	if uptime.Periods > 0 {
+		uptime.UptimePercentage = float64(uptime.HealthyPeriods) / float64(uptime.Periods) * 100
+	}
+
+	jsonString, err := json.Marshal(uptime)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func init() {
+	AwsxRoute53HealthCheckUptimeCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxRoute53HealthCheckUptimeCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxRoute53HealthCheckUptimeCmd.PersistentFlags().String("query", "", "query")
+	AwsxRoute53HealthCheckUptimeCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxRoute53HealthCheckUptimeCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxRoute53HealthCheckUptimeCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxRoute53HealthCheckUptimeCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxRoute53HealthCheckUptimeCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxRoute53HealthCheckUptimeCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxRoute53HealthCheckUptimeCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxRoute53HealthCheckUptimeCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxRoute53HealthCheckUptimeCmd.PersistentFlags().String("HealthCheckId", "", "route 53 health check id")
+	AwsxRoute53HealthCheckUptimeCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxRoute53HealthCheckUptimeCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxRoute53HealthCheckUptimeCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxRoute53HealthCheckUptimeCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,213 @@
+package Route53
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// defaultTopN is the number of query names returned without the topN flag
+const defaultTopN = 10
+
+type QueryVolume struct {
+	QueryName    string `json:"queryName"`
+	QueryType    string `json:"queryType"`
+	ResponseCode string `json:"responseCode"`
+	Queries      int64  `json:"queries"`
+}
+
+// queryLogFields are the space separated fields of a Route 53 query log line
+const queryLogFields = "version, queryTimestamp, hostedZoneId, queryName, queryType, responseCode, protocol, edgeLocation, resolverIp, ednsClientSubnet"
+
+var AwsxRoute53QueryVolumeCmd = &cobra.Command{
+	Use:   "route53_query_volume_panel",
+	Short: "get most queried names of the hosted zone",
+	Long:  `command to get the most queried names of the hosted zone by query type and response code from its query logs`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, table, err := GetRoute53QueryVolumePanel(cmd, clientAuth)
+			if err != nil {
+				log.Println("Error getting route53 query volume: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(table)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetRoute53QueryVolumePanel returns the most queried names of the hosted zone by query type
+// and response code, counted from its query logs. The log group is the one of the query
+// logging config of the zone, or the logGroupName flag. Without startTime the last hour is
+// read.
+func GetRoute53QueryVolumePanel(cmd *cobra.Command, clientAuth *model.Auth) (string, string, error) {
+	logGroupName, _ := cmd.PersistentFlags().GetString("logGroupName")
+	topNStr, _ := cmd.PersistentFlags().GetString("topN")
+
+	hostedZoneId, err := GetHostedZoneId(cmd)
+	if err != nil {
+		return "", "", err
+	}
+
+	topN := defaultTopN
+	if topNStr != "" {
+		parsedTopN, err := strconv.Atoi(topNStr)
+		if err != nil || parsedTopN < 1 {
+			return "", "", fmt.Errorf("invalid topN %q", topNStr)
+		}
+		topN = parsedTopN
+	}
+
+	if logGroupName == "" {
+		logGroupName, err = GetQueryLogGroupName(clientAuth, hostedZoneId)
+		if err != nil {
+			log.Println("Error getting query log group: ", err)
+			return "", "", err
+		}
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, time.Hour)
+	if err != nil {
+		return "", "", err
+	}
+
+	queryVolumes, err := GetQueryVolumes(clientAuth, logGroupName, topN, startTime, endTime)
+	if err != nil {
+		log.Println("Error getting query volumes: ", err)
+		return "", "", err
+	}
+
+	jsonString, err := json.Marshal(queryVolumes)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", "", err
+	}
+	return string(jsonString), printQueryVolumeTable(queryVolumes), nil
+}
+
+// GetQueryVolumes counts the queries in the query log group by name, type and response
+// code with a Logs Insights query, most queried first.
+func GetQueryVolumes(clientAuth *model.Auth, logGroupName string, topN int, startTime, endTime *time.Time) ([]QueryVolume, error) {
+	cloudWatchLogs := awsclient.GetClient(*globalAuth(clientAuth), awsclient.CLOUDWATCH_LOG).(*cloudwatchlogs.CloudWatchLogs)
+
+	queryResult, err := cloudWatchLogs.StartQuery(&cloudwatchlogs.StartQueryInput{
+		LogGroupName: aws.String(logGroupName),
+		StartTime:    aws.Int64(startTime.Unix() * 1000),
+		EndTime:      aws.Int64(endTime.Unix() * 1000),
+		QueryString: aws.String(fmt.Sprintf(`parse @message "* * * * * * * * * *" as %s
+		| stats count(*) as queries by queryName, queryType, responseCode
+		| sort queries desc
+		| limit %d`, queryLogFields, topN)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start query: %v", err)
+	}
+
+	var output *cloudwatchlogs.GetQueryResultsOutput
+	for {
+		output, err = cloudWatchLogs.GetQueryResults(&cloudwatchlogs.GetQueryResultsInput{
+			QueryId: queryResult.QueryId,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get query results: %v", err)
+		}
+		status := aws.StringValue(output.Status)
+		if status == cloudwatchlogs.QueryStatusComplete {
+			break
+		}
+		if status != cloudwatchlogs.QueryStatusScheduled && status != cloudwatchlogs.QueryStatusRunning {
+			return nil, fmt.Errorf("query ended with status %s", status)
+		}
+		time.Sleep(time.Second)
+	}
+
+	queryVolumes := []QueryVolume{}
+	for _, row := range output.Results {
+		var queryVolume QueryVolume
+		for _, field := range row {
+			switch aws.StringValue(field.Field) {
+			case "queryName":
+				queryVolume.QueryName = aws.StringValue(field.Value)
+			case "queryType":
+				queryVolume.QueryType = aws.StringValue(field.Value)
+			case "responseCode":
+				queryVolume.ResponseCode = aws.StringValue(field.Value)
+			case "queries":
+				queryVolume.Queries, _ = strconv.ParseInt(aws.StringValue(field.Value), 10, 64)
+			}
+		}
+		queryVolumes = append(queryVolumes, queryVolume)
+	}
+	return queryVolumes, nil
+}
+
+func printQueryVolumeTable(queryVolumes []QueryVolume) string {
+	var buffer bytes.Buffer
+	if len(queryVolumes) == 0 {
+		buffer.WriteString("No queries found.")
+		return buffer.String()
+	}
+
+	table := tablewriter.NewWriter(&buffer)
+	table.SetHeader([]string{"Query Name", "Query Type", "Response Code", "Queries"})
+	for _, queryVolume := range queryVolumes {
+		table.Append([]string{
+			queryVolume.QueryName,
+			queryVolume.QueryType,
+			queryVolume.ResponseCode,
+			strconv.FormatInt(queryVolume.Queries, 10),
+		})
+	}
+	table.Render()
+	return buffer.String()
+}
+
+func init() {
+	AwsxRoute53QueryVolumeCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxRoute53QueryVolumeCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxRoute53QueryVolumeCmd.PersistentFlags().String("query", "", "query")
+	AwsxRoute53QueryVolumeCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxRoute53QueryVolumeCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxRoute53QueryVolumeCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxRoute53QueryVolumeCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxRoute53QueryVolumeCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxRoute53QueryVolumeCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxRoute53QueryVolumeCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxRoute53QueryVolumeCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxRoute53QueryVolumeCmd.PersistentFlags().String("HostedZoneId", "", "route 53 hosted zone id")
+	AwsxRoute53QueryVolumeCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxRoute53QueryVolumeCmd.PersistentFlags().String("logGroupName", "", "query log group. looked up from the hosted zone when not given")
+	AwsxRoute53QueryVolumeCmd.PersistentFlags().String("topN", "", "number of query names to return. defaults to 10")
+	AwsxRoute53QueryVolumeCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxRoute53QueryVolumeCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxRoute53QueryVolumeCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,128 @@
+package Route53
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/cmdb"
+	"github.com/Appkube-awsx/awsx-common/config"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/spf13/cobra"
+)
+
+// metricsRegion is where Route 53 publishes its health check and DNS query metrics and
+// where public hosted zones write their query logs.
+const metricsRegion = "us-east-1"
+
+// GetHealthCheckId returns the health check from the HealthCheckId flag, the CMDB element or
+// the instanceId flag, in that order.
+func GetHealthCheckId(cmd *cobra.Command) (string, error) {
+	healthCheckId, _ := cmd.PersistentFlags().GetString("HealthCheckId")
+	elementId, _ := cmd.PersistentFlags().GetString("elementId")
+	cmdbApiUrl, _ := cmd.PersistentFlags().GetString("cmdbApiUrl")
+	instanceId, _ := cmd.PersistentFlags().GetString("instanceId")
+
+	if healthCheckId != "" {
+		return healthCheckId, nil
+	}
+
+	if elementId != "" {
+		log.Println("getting cloud-element data from cmdb")
+		apiUrl := cmdbApiUrl
+		if cmdbApiUrl == "" {
+			log.Println("using default cmdb url")
+			apiUrl = config.CmdbUrl
+		}
+		log.Println("cmdb url: " + apiUrl)
+		cmdbData, err := cmdb.GetCloudElementData(apiUrl, elementId)
+		if err != nil {
+			return "", err
+		}
+		instanceId = cmdbData.InstanceId
+	}
+
+	if instanceId == "" {
+		return "", errors.New("health check id not provided. provide HealthCheckId or elementId")
+	}
+	return instanceId, nil
+}
+
+// GetHostedZoneId returns the hosted zone from the HostedZoneId flag, the CMDB element or
+// the instanceId flag, in that order. A /hostedzone/ prefix is removed.
+func GetHostedZoneId(cmd *cobra.Command) (string, error) {
+	hostedZoneId, _ := cmd.PersistentFlags().GetString("HostedZoneId")
+	elementId, _ := cmd.PersistentFlags().GetString("elementId")
+	cmdbApiUrl, _ := cmd.PersistentFlags().GetString("cmdbApiUrl")
+	instanceId, _ := cmd.PersistentFlags().GetString("instanceId")
+
+	if hostedZoneId != "" {
+		return strings.TrimPrefix(hostedZoneId, "/hostedzone/"), nil
+	}
+
+	if elementId != "" {
+		log.Println("getting cloud-element data from cmdb")
+		apiUrl := cmdbApiUrl
+		if cmdbApiUrl == "" {
+			log.Println("using default cmdb url")
+			apiUrl = config.CmdbUrl
+		}
+		log.Println("cmdb url: " + apiUrl)
+		cmdbData, err := cmdb.GetCloudElementData(apiUrl, elementId)
+		if err != nil {
+			return "", err
+		}
+		instanceId = cmdbData.InstanceId
+	}
+
+	if instanceId == "" {
+		return "", errors.New("hosted zone id not provided. provide HostedZoneId or elementId")
+	}
+	return strings.TrimPrefix(instanceId, "/hostedzone/"), nil
+}
+
+// globalAuth returns a copy of clientAuth pointed at metricsRegion, so that the --zone
+// flag does not decide where Route 53 is queried.
+func globalAuth(clientAuth *model.Auth) *model.Auth {
+	auth := *clientAuth
+	auth.Region = metricsRegion
+	return &auth
+}
+
+// metricsClient returns cloudWatchClient if it is in metricsRegion, and a new client there
+// otherwise, as a client for another region would find no data.
+func metricsClient(clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) *cloudwatch.CloudWatch {
+	if cloudWatchClient == nil || aws.StringValue(cloudWatchClient.Config.Region) != metricsRegion {
+		cloudWatchClient = awsclient.GetClient(*globalAuth(clientAuth), awsclient.CLOUDWATCH).(*cloudwatch.CloudWatch)
+	}
+	return cloudWatchClient
+}
+
+// GetQueryLogGroupName returns the log group the hosted zone writes its query logs to.
+func GetQueryLogGroupName(clientAuth *model.Auth, hostedZoneId string) (string, error) {
+	// awsclient has no Route 53 client type, so the client is built on the assumed role session
+	route53Client := route53.New(awsclient.GetSessionWithAssumeRole(*globalAuth(clientAuth)))
+
+	output, err := route53Client.ListQueryLoggingConfigs(&route53.ListQueryLoggingConfigsInput{
+		HostedZoneId: aws.String(hostedZoneId),
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(output.QueryLoggingConfigs) == 0 {
+		return "", fmt.Errorf("query logging is not enabled for hosted zone %s", hostedZoneId)
+	}
+
+	// arn:aws:logs:us-east-1:<account>:log-group:<name>[:*]
+	logGroupArn := aws.StringValue(output.QueryLoggingConfigs[0].CloudWatchLogsLogGroupArn)
+	arnFields := strings.Split(logGroupArn, ":")
+	if len(arnFields) < 7 {
+		return "", fmt.Errorf("invalid log group arn %s", logGroupArn)
+	}
+	return arnFields[6], nil
+}
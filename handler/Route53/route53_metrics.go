@@ -0,0 +1,49 @@
+package Route53
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+const namespace = "AWS/Route53"
+
+// period is the granularity Route 53 publishes its metrics at
+const period = 60
+
+// GetRoute53HealthCheckPanel resolves the health check and returns the given series of it,
+// aggregated over all checker regions.
+func GetRoute53HealthCheckPanel(cmd *cobra.Command, clientAuth *model.Auth, series []Metric.Series, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	healthCheckId, err := GetHealthCheckId(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result, cloudwatchMetricData, err := Metric.GetMetricData(clientAuth, namespace, period, healthCheckDimensions(healthCheckId), series, startTime, endTime, metricsClient(clientAuth, cloudWatchClient))
+	if err != nil {
+		log.Println("Error in getting health check metric data: ", err)
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func healthCheckDimensions(healthCheckId string) []*cloudwatch.Dimension {
+	return Metric.Dimensions("HealthCheckId", healthCheckId)
+}
@@ -0,0 +1,43 @@
+package S3
+
+import (
+	"errors"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/cmdb"
+	"github.com/Appkube-awsx/awsx-common/config"
+	"github.com/spf13/cobra"
+)
+
+// GetS3BucketName returns the bucket from the BucketName flag, the CMDB element or the
+// instanceId flag, in that order.
+func GetS3BucketName(cmd *cobra.Command) (string, error) {
+	bucketName, _ := cmd.PersistentFlags().GetString("BucketName")
+	elementId, _ := cmd.PersistentFlags().GetString("elementId")
+	cmdbApiUrl, _ := cmd.PersistentFlags().GetString("cmdbApiUrl")
+	instanceId, _ := cmd.PersistentFlags().GetString("instanceId")
+
+	if bucketName != "" {
+		return bucketName, nil
+	}
+
+	if elementId != "" {
+		log.Println("getting cloud-element data from cmdb")
+		apiUrl := cmdbApiUrl
+		if cmdbApiUrl == "" {
+			log.Println("using default cmdb url")
+			apiUrl = config.CmdbUrl
+		}
+		log.Println("cmdb url: " + apiUrl)
+		cmdbData, err := cmdb.GetCloudElementData(apiUrl, elementId)
+		if err != nil {
+			return "", err
+		}
+		instanceId = cmdbData.InstanceId
+	}
+
+	if instanceId == "" {
+		return "", errors.New("bucket name not provided. provide BucketName or elementId")
+	}
+	return instanceId, nil
+}
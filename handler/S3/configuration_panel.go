@@ -0,0 +1,238 @@
+package S3
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+type PublicAccessBlock struct {
+	BlockPublicAcls       bool `json:"blockPublicAcls"`
+	IgnorePublicAcls      bool `json:"ignorePublicAcls"`
+	BlockPublicPolicy     bool `json:"blockPublicPolicy"`
+	RestrictPublicBuckets bool `json:"restrictPublicBuckets"`
+}
+
+type LifecycleRule struct {
+	Id             string   `json:"id"`
+	Status         string   `json:"status"`
+	Prefix         string   `json:"prefix,omitempty"`
+	ExpirationDays int64    `json:"expirationDays,omitempty"`
+	Transitions    []string `json:"transitions,omitempty"`
+}
+
+type BucketConfiguration struct {
+	BucketName string `json:"bucketName"`
+	Versioning string `json:"versioning"`
+	// Encryption lists the default encryption rules, e.g. aws:kms or AES256
+	Encryption []string `json:"encryption"`
+	// PublicAccessBlock is nil when the bucket has no public access block
+	PublicAccessBlock *PublicAccessBlock `json:"publicAccessBlock"`
+	LifecycleRules    []LifecycleRule    `json:"lifecycleRules"`
+}
+
+var AwsxS3ConfigurationCmd = &cobra.Command{
+	Use:   "s3_configuration_panel",
+	Short: "get the configuration of the bucket",
+	Long:  `command to get the versioning, encryption, public access block and lifecycle rules of the bucket`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, table, err := GetS3ConfigurationPanel(cmd, clientAuth)
+			if err != nil {
+				log.Println("Error getting s3 configuration: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(table)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetS3ConfigurationPanel returns the versioning, default encryption, public access block
+// and lifecycle rules of the bucket.
+func GetS3ConfigurationPanel(cmd *cobra.Command, clientAuth *model.Auth) (string, string, error) {
+	bucketName, err := GetS3BucketName(cmd)
+	if err != nil {
+		return "", "", err
+	}
+
+	configuration, err := GetBucketConfiguration(clientAuth, bucketName)
+	if err != nil {
+		log.Println("Error getting bucket configuration: ", err)
+		return "", "", err
+	}
+
+	jsonString, err := json.Marshal(configuration)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", "", err
+	}
+	return string(jsonString), printConfigurationTable(configuration), nil
+}
+
+// GetBucketConfiguration reads the bucket configuration. Missing encryption, public access
+// block and lifecycle configurations are reported as empty rather than as errors.
+func GetBucketConfiguration(clientAuth *model.Auth, bucketName string) (*BucketConfiguration, error) {
+	s3Client := awsclient.GetClient(*clientAuth, awsclient.S3_CLIENT).(*s3.S3)
+	bucket := aws.String(bucketName)
+
+	configuration := &BucketConfiguration{
+		BucketName:     bucketName,
+		Versioning:     "Disabled",
+		Encryption:     []string{},
+		LifecycleRules: []LifecycleRule{},
+	}
+
+	versioning, err := s3Client.GetBucketVersioning(&s3.GetBucketVersioningInput{Bucket: bucket})
+	if err != nil {
+		return nil, err
+	}
+	if versioning.Status != nil {
+		configuration.Versioning = aws.StringValue(versioning.Status)
+	}
+
+	encryption, err := s3Client.GetBucketEncryption(&s3.GetBucketEncryptionInput{Bucket: bucket})
+	if err != nil && !isErrorCode(err, "ServerSideEncryptionConfigurationNotFoundError") {
+		return nil, err
+	}
+	if err == nil && encryption.ServerSideEncryptionConfiguration != nil {
+		for _, rule := range encryption.ServerSideEncryptionConfiguration.Rules {
+			if rule.ApplyServerSideEncryptionByDefault == nil {
+				continue
+			}
+			algorithm := aws.StringValue(rule.ApplyServerSideEncryptionByDefault.SSEAlgorithm)
+			if keyId := aws.StringValue(rule.ApplyServerSideEncryptionByDefault.KMSMasterKeyID); keyId != "" {
+				algorithm += " (" + keyId + ")"
+			}
+			configuration.Encryption = append(configuration.Encryption, algorithm)
+		}
+	}
+
+	publicAccessBlock, err := s3Client.GetPublicAccessBlock(&s3.GetPublicAccessBlockInput{Bucket: bucket})
+	if err != nil && !isErrorCode(err, "NoSuchPublicAccessBlockConfiguration") {
+		return nil, err
+	}
+	if err == nil && publicAccessBlock.PublicAccessBlockConfiguration != nil {
+		block := publicAccessBlock.PublicAccessBlockConfiguration
+		configuration.PublicAccessBlock = &PublicAccessBlock{
+			BlockPublicAcls:       aws.BoolValue(block.BlockPublicAcls),
+			IgnorePublicAcls:      aws.BoolValue(block.IgnorePublicAcls),
+			BlockPublicPolicy:     aws.BoolValue(block.BlockPublicPolicy),
+			RestrictPublicBuckets: aws.BoolValue(block.RestrictPublicBuckets),
+		}
+	}
+
+	lifecycle, err := s3Client.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{Bucket: bucket})
+	if err != nil && !isErrorCode(err, "NoSuchLifecycleConfiguration") {
+		return nil, err
+	}
+	if err == nil {
+		for _, rule := range lifecycle.Rules {
+			lifecycleRule := LifecycleRule{
+				Id:     aws.StringValue(rule.ID),
+				Status: aws.StringValue(rule.Status),
+				Prefix: aws.StringValue(rule.Prefix),
+			}
+			if rule.Filter != nil && rule.Filter.Prefix != nil {
+				lifecycleRule.Prefix = aws.StringValue(rule.Filter.Prefix)
+			}
+			if rule.Expiration != nil {
+				lifecycleRule.ExpirationDays = aws.Int64Value(rule.Expiration.Days)
+			}
+			for _, transition := range rule.Transitions {
+				lifecycleRule.Transitions = append(lifecycleRule.Transitions, fmt.Sprintf("%s after %d days", aws.StringValue(transition.StorageClass), aws.Int64Value(transition.Days)))
+			}
+			configuration.LifecycleRules = append(configuration.LifecycleRules, lifecycleRule)
+		}
+	}
+
+	return configuration, nil
+}
+
+func isErrorCode(err error, code string) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == code
+}
+
+func printConfigurationTable(configuration *BucketConfiguration) string {
+	var buffer bytes.Buffer
+	table := tablewriter.NewWriter(&buffer)
+	table.SetHeader([]string{"Setting", "Value"})
+
+	encryption := "None"
+	if len(configuration.Encryption) > 0 {
+		encryption = strings.Join(configuration.Encryption, ", ")
+	}
+	publicAccessBlock := "Not configured"
+	if block := configuration.PublicAccessBlock; block != nil {
+		publicAccessBlock = fmt.Sprintf("BlockPublicAcls=%t, IgnorePublicAcls=%t, BlockPublicPolicy=%t, RestrictPublicBuckets=%t",
+			block.BlockPublicAcls, block.IgnorePublicAcls, block.BlockPublicPolicy, block.RestrictPublicBuckets)
+	}
+
+	table.Append([]string{"Bucket", configuration.BucketName})
+	table.Append([]string{"Versioning", configuration.Versioning})
+	table.Append([]string{"Encryption", encryption})
+	table.Append([]string{"Public Access Block", publicAccessBlock})
+	table.Append([]string{"Lifecycle Rules", strconv.Itoa(len(configuration.LifecycleRules))})
+	for _, rule := range configuration.LifecycleRules {
+		description := rule.Status
+		if rule.Prefix != "" {
+			description += ", prefix " + rule.Prefix
+		}
+		if rule.ExpirationDays > 0 {
+			description += fmt.Sprintf(", expires after %d days", rule.ExpirationDays)
+		}
+		if len(rule.Transitions) > 0 {
+			description += ", " + strings.Join(rule.Transitions, ", ")
+		}
+		table.Append([]string{"  " + rule.Id, description})
+	}
+	table.Render()
+	return buffer.String()
+}
+
+func init() {
+	AwsxS3ConfigurationCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxS3ConfigurationCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxS3ConfigurationCmd.PersistentFlags().String("query", "", "query")
+	AwsxS3ConfigurationCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxS3ConfigurationCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxS3ConfigurationCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxS3ConfigurationCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxS3ConfigurationCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxS3ConfigurationCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxS3ConfigurationCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxS3ConfigurationCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxS3ConfigurationCmd.PersistentFlags().String("BucketName", "", "s3 bucket name")
+	AwsxS3ConfigurationCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxS3ConfigurationCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
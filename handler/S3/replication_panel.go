@@ -0,0 +1,130 @@
+package S3
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var replicationSeries = []Metric.Series{
+	{Label: "ReplicationLatency", MetricName: "ReplicationLatency", Statistic: "Maximum"},
+	{Label: "OperationsPendingReplication", MetricName: "OperationsPendingReplication", Statistic: "Maximum"},
+	{Label: "BytesPendingReplication", MetricName: "BytesPendingReplication", Statistic: "Maximum"},
+}
+
+var AwsxS3ReplicationCmd = &cobra.Command{
+	Use:   "s3_replication_panel",
+	Short: "get replication latency and backlog of the bucket",
+	Long:  `command to get the replication latency and pending operations and bytes per replication rule`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetS3ReplicationPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting s3 replication: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetS3ReplicationPanel returns the replication latency and backlog of every replication
+// rule of the bucket, keyed by <destination bucket>/<rule id>. S3 publishes them only for
+// rules with replication metrics enabled.
+func GetS3ReplicationPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	bucketName, err := GetS3BucketName(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if cloudWatchClient == nil {
+		cloudWatchClient = awsclient.GetClient(*clientAuth, awsclient.CLOUDWATCH).(*cloudwatch.CloudWatch)
+	}
+
+	metrics, err := Metric.ListMetrics(cloudWatchClient, namespace, "ReplicationLatency", []*cloudwatch.DimensionFilter{
+		{
+			Name:  aws.String("SourceBucket"),
+			Value: aws.String(bucketName),
+		},
+	})
+	if err != nil {
+		log.Println("Error listing replication metrics: ", err)
+		return "", nil, err
+	}
+
+	result := map[string]map[string][]Metric.DataPoint{}
+	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
+	for _, metric := range metrics {
+		rule := Metric.DimensionValue(metric.Dimensions, "DestinationBucket") + "/" + Metric.DimensionValue(metric.Dimensions, "RuleId")
+		series, rawData, err := Metric.GetMetricData(clientAuth, namespace, requestPeriod, metric.Dimensions, replicationSeries, startTime, endTime, cloudWatchClient)
+		if err != nil {
+			log.Println("Error in getting replication metric data: ", err)
+			return "", nil, err
+		}
+		result[rule] = series
+		output := &cloudwatch.GetMetricDataOutput{}
+		for _, s := range replicationSeries {
+			output.MetricDataResults = append(output.MetricDataResults, rawData[s.Label].MetricDataResults...)
+		}
+		cloudwatchMetricData[rule] = output
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func init() {
+	AwsxS3ReplicationCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxS3ReplicationCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxS3ReplicationCmd.PersistentFlags().String("query", "", "query")
+	AwsxS3ReplicationCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxS3ReplicationCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxS3ReplicationCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxS3ReplicationCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxS3ReplicationCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxS3ReplicationCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxS3ReplicationCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxS3ReplicationCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxS3ReplicationCmd.PersistentFlags().String("BucketName", "", "s3 bucket name")
+	AwsxS3ReplicationCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxS3ReplicationCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxS3ReplicationCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxS3ReplicationCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,135 @@
+package S3
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/spf13/cobra"
+)
+
+var requestMetricsSeries = []Metric.Series{
+	{Label: "AllRequests", MetricName: "AllRequests", Statistic: "Sum"},
+	{Label: "4xxErrors", MetricName: "4xxErrors", Statistic: "Sum"},
+	{Label: "5xxErrors", MetricName: "5xxErrors", Statistic: "Sum"},
+	{Label: "FirstByteLatency", MetricName: "FirstByteLatency", Statistic: "Average"},
+	{Label: "TotalRequestLatency", MetricName: "TotalRequestLatency", Statistic: "Average"},
+}
+
+var AwsxS3RequestMetricsCmd = &cobra.Command{
+	Use:   "s3_request_metrics_panel",
+	Short: "get request, error and latency metrics of the bucket",
+	Long:  `command to get the request, 4xx/5xx error and latency metrics of a bucket metrics configuration`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetS3RequestMetricsPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting s3 request metrics: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetS3RequestMetricsPanel returns the request metrics of the bucket. S3 only publishes
+// them for a metrics configuration, given by the FilterId flag or else the first
+// configuration of the bucket.
+func GetS3RequestMetricsPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	filterId, _ := cmd.PersistentFlags().GetString("FilterId")
+
+	bucketName, err := GetS3BucketName(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if filterId == "" {
+		s3Client := awsclient.GetClient(*clientAuth, awsclient.S3_CLIENT).(*s3.S3)
+		output, err := s3Client.ListBucketMetricsConfigurations(&s3.ListBucketMetricsConfigurationsInput{
+			Bucket: aws.String(bucketName),
+		})
+		if err != nil {
+			log.Println("Error listing bucket metrics configurations: ", err)
+			return "", nil, err
+		}
+		if len(output.MetricsConfigurationList) == 0 {
+			return "", nil, fmt.Errorf("bucket %s has no request metrics configuration", bucketName)
+		}
+		filterId = aws.StringValue(output.MetricsConfigurationList[0].Id)
+	}
+
+	dimensions := []*cloudwatch.Dimension{
+		{
+			Name:  aws.String("BucketName"),
+			Value: aws.String(bucketName),
+		},
+		{
+			Name:  aws.String("FilterId"),
+			Value: aws.String(filterId),
+		},
+	}
+	result, cloudwatchMetricData, err := Metric.GetMetricData(clientAuth, namespace, requestPeriod, dimensions, requestMetricsSeries, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting request metric data: ", err)
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func init() {
+	AwsxS3RequestMetricsCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxS3RequestMetricsCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxS3RequestMetricsCmd.PersistentFlags().String("query", "", "query")
+	AwsxS3RequestMetricsCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxS3RequestMetricsCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxS3RequestMetricsCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxS3RequestMetricsCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxS3RequestMetricsCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxS3RequestMetricsCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxS3RequestMetricsCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxS3RequestMetricsCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxS3RequestMetricsCmd.PersistentFlags().String("BucketName", "", "s3 bucket name")
+	AwsxS3RequestMetricsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxS3RequestMetricsCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxS3RequestMetricsCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxS3RequestMetricsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+	AwsxS3RequestMetricsCmd.PersistentFlags().String("FilterId", "", "request metrics configuration id. defaults to the first configuration")
+}
@@ -0,0 +1,10 @@
+package S3
+
+const namespace = "AWS/S3"
+
+const (
+	// storagePeriod is the granularity of the daily storage metrics
+	storagePeriod = 86400
+	// requestPeriod is the granularity of the request and replication metrics
+	requestPeriod = 60
+)
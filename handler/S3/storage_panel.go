@@ -0,0 +1,151 @@
+package S3
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+// storageMetrics are published once a day per StorageType. NumberOfObjects only has
+// the AllStorageTypes storage type.
+var storageMetrics = []string{"BucketSizeBytes", "NumberOfObjects"}
+
+var AwsxS3StorageCmd = &cobra.Command{
+	Use:   "s3_storage_panel",
+	Short: "get bucket size and object count per storage class",
+	Long:  `command to get the daily bucket size and number of objects per storage class`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetS3StoragePanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting s3 storage: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetS3StoragePanel returns BucketSizeBytes and NumberOfObjects keyed by storage type.
+// The range defaults to the last 14 days since the metrics are daily.
+func GetS3StoragePanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	bucketName, err := GetS3BucketName(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 14*24*time.Hour)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if cloudWatchClient == nil {
+		cloudWatchClient = awsclient.GetClient(*clientAuth, awsclient.CLOUDWATCH).(*cloudwatch.CloudWatch)
+	}
+
+	filters := []*cloudwatch.DimensionFilter{
+		{
+			Name:  aws.String("BucketName"),
+			Value: aws.String(bucketName),
+		},
+	}
+	storageTypes := map[string][]Metric.Series{}
+	for _, metricName := range storageMetrics {
+		metrics, err := Metric.ListMetrics(cloudWatchClient, namespace, metricName, filters)
+		if err != nil {
+			log.Println("Error listing storage metrics: ", err)
+			return "", nil, err
+		}
+		for _, metric := range metrics {
+			storageType := Metric.DimensionValue(metric.Dimensions, "StorageType")
+			storageTypes[storageType] = append(storageTypes[storageType], Metric.Series{Label: metricName, MetricName: metricName, Statistic: "Average"})
+		}
+	}
+
+	var types []string
+	for storageType := range storageTypes {
+		types = append(types, storageType)
+	}
+	sort.Strings(types)
+
+	result := map[string]map[string][]Metric.DataPoint{}
+	cloudwatchMetricData := map[string]*cloudwatch.GetMetricDataOutput{}
+	for _, storageType := range types {
+		dimensions := []*cloudwatch.Dimension{
+			{
+				Name:  aws.String("BucketName"),
+				Value: aws.String(bucketName),
+			},
+			{
+				Name:  aws.String("StorageType"),
+				Value: aws.String(storageType),
+			},
+		}
+		series, rawData, err := Metric.GetMetricData(clientAuth, namespace, storagePeriod, dimensions, storageTypes[storageType], startTime, endTime, cloudWatchClient)
+		if err != nil {
+			log.Println("Error in getting storage metric data: ", err)
+			return "", nil, err
+		}
+		result[storageType] = series
+		output := &cloudwatch.GetMetricDataOutput{}
+		for _, s := range storageTypes[storageType] {
+			output.MetricDataResults = append(output.MetricDataResults, rawData[s.Label].MetricDataResults...)
+		}
+		cloudwatchMetricData[storageType] = output
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func init() {
+	AwsxS3StorageCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxS3StorageCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxS3StorageCmd.PersistentFlags().String("query", "", "query")
+	AwsxS3StorageCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxS3StorageCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxS3StorageCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxS3StorageCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxS3StorageCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxS3StorageCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxS3StorageCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxS3StorageCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxS3StorageCmd.PersistentFlags().String("BucketName", "", "s3 bucket name")
+	AwsxS3StorageCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxS3StorageCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxS3StorageCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxS3StorageCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,76 @@
+package SNS
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var filteredNotificationsSeries = []Metric.Series{
+	{Label: "NumberOfNotificationsFilteredOut", MetricName: "NumberOfNotificationsFilteredOut", Statistic: "Sum"},
+	{Label: "NumberOfNotificationsFilteredOut-InvalidAttributes", MetricName: "NumberOfNotificationsFilteredOut-InvalidAttributes", Statistic: "Sum"},
+	{Label: "NumberOfNotificationsFilteredOut-NoMessageAttributes", MetricName: "NumberOfNotificationsFilteredOut-NoMessageAttributes", Statistic: "Sum"},
+	{Label: "NumberOfNotificationsFilteredOut-InvalidMessageBody", MetricName: "NumberOfNotificationsFilteredOut-InvalidMessageBody", Statistic: "Sum"},
+}
+
+var AwsxSNSFilteredNotificationsCmd = &cobra.Command{
+	Use:   "sns_filtered_notifications_panel",
+	Short: "get notifications filtered out by subscription filter policies",
+	Long:  `command to get the notifications of the topic rejected by subscription filter policies, by reason`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetSNSFilteredNotificationsPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting sns filtered notifications: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetSNSFilteredNotificationsPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetSNSTopicPanel(cmd, clientAuth, filteredNotificationsSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxSNSFilteredNotificationsCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxSNSFilteredNotificationsCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxSNSFilteredNotificationsCmd.PersistentFlags().String("query", "", "query")
+	AwsxSNSFilteredNotificationsCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxSNSFilteredNotificationsCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxSNSFilteredNotificationsCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxSNSFilteredNotificationsCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxSNSFilteredNotificationsCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxSNSFilteredNotificationsCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxSNSFilteredNotificationsCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxSNSFilteredNotificationsCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxSNSFilteredNotificationsCmd.PersistentFlags().String("TopicName", "", "sns topic name or arn")
+	AwsxSNSFilteredNotificationsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxSNSFilteredNotificationsCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxSNSFilteredNotificationsCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxSNSFilteredNotificationsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
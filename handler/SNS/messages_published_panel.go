@@ -0,0 +1,74 @@
+package SNS
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var messagesPublishedSeries = []Metric.Series{
+	{Label: "NumberOfMessagesPublished", MetricName: "NumberOfMessagesPublished", Statistic: "Sum"},
+	{Label: "PublishSize", MetricName: "PublishSize", Statistic: "Average"},
+}
+
+var AwsxSNSMessagesPublishedCmd = &cobra.Command{
+	Use:   "sns_messages_published_panel",
+	Short: "get messages published to the topic",
+	Long:  `command to get the number and average size of the messages published to the topic`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetSNSMessagesPublishedPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting sns messages published: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetSNSMessagesPublishedPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetSNSTopicPanel(cmd, clientAuth, messagesPublishedSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxSNSMessagesPublishedCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxSNSMessagesPublishedCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxSNSMessagesPublishedCmd.PersistentFlags().String("query", "", "query")
+	AwsxSNSMessagesPublishedCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxSNSMessagesPublishedCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxSNSMessagesPublishedCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxSNSMessagesPublishedCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxSNSMessagesPublishedCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxSNSMessagesPublishedCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxSNSMessagesPublishedCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxSNSMessagesPublishedCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxSNSMessagesPublishedCmd.PersistentFlags().String("TopicName", "", "sns topic name or arn")
+	AwsxSNSMessagesPublishedCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxSNSMessagesPublishedCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxSNSMessagesPublishedCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxSNSMessagesPublishedCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
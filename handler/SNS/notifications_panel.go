@@ -0,0 +1,74 @@
+package SNS
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var notificationsSeries = []Metric.Series{
+	{Label: "NumberOfNotificationsDelivered", MetricName: "NumberOfNotificationsDelivered", Statistic: "Sum"},
+	{Label: "NumberOfNotificationsFailed", MetricName: "NumberOfNotificationsFailed", Statistic: "Sum"},
+}
+
+var AwsxSNSNotificationsCmd = &cobra.Command{
+	Use:   "sns_notifications_panel",
+	Short: "get delivered and failed notifications of the topic",
+	Long:  `command to get the notifications of the topic delivered to and failed for its subscriptions`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetSNSNotificationsPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting sns notifications: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetSNSNotificationsPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetSNSTopicPanel(cmd, clientAuth, notificationsSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxSNSNotificationsCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxSNSNotificationsCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxSNSNotificationsCmd.PersistentFlags().String("query", "", "query")
+	AwsxSNSNotificationsCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxSNSNotificationsCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxSNSNotificationsCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxSNSNotificationsCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxSNSNotificationsCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxSNSNotificationsCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxSNSNotificationsCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxSNSNotificationsCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxSNSNotificationsCmd.PersistentFlags().String("TopicName", "", "sns topic name or arn")
+	AwsxSNSNotificationsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxSNSNotificationsCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxSNSNotificationsCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxSNSNotificationsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,258 @@
+package SNS
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+type ProtocolFailures struct {
+	Protocol string `json:"protocol"`
+	Failures int64  `json:"failures"`
+	// Endpoints is the number of distinct endpoints that had failures
+	Endpoints int `json:"endpoints"`
+}
+
+// destinationProtocols maps destination prefixes to protocols for destinations that are
+// no longer subscribed
+var destinationProtocols = []struct {
+	prefix   string
+	protocol string
+}{
+	{"arn:aws:sqs:", "sqs"},
+	{"arn:aws:lambda:", "lambda"},
+	{"arn:aws:firehose:", "firehose"},
+	{"arn:aws:sns:", "application"},
+	{"https://", "https"},
+	{"http://", "http"},
+}
+
+var AwsxSNSProtocolFailuresCmd = &cobra.Command{
+	Use:   "sns_protocol_failures_panel",
+	Short: "get delivery failures of the topic by protocol",
+	Long:  `command to get the delivery failures of the topic by subscription protocol from its delivery status logs`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, table, err := GetSNSProtocolFailuresPanel(cmd, clientAuth)
+			if err != nil {
+				log.Println("Error getting sns protocol failures: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(table)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetSNSProtocolFailuresPanel returns the delivery failures of the topic by subscription
+// protocol. CloudWatch has no protocol dimension, so the failures are counted from the
+// delivery status logs in sns/<region>/<account>/<topic>/Failure, or the logGroupName flag.
+// Only protocols with delivery status logging enabled are covered. Without startTime the
+// last hour is read.
+func GetSNSProtocolFailuresPanel(cmd *cobra.Command, clientAuth *model.Auth) (string, string, error) {
+	logGroupName, _ := cmd.PersistentFlags().GetString("logGroupName")
+
+	topic, err := GetSNSTopicName(cmd)
+	if err != nil {
+		return "", "", err
+	}
+
+	topicArn, err := GetTopicArn(clientAuth, topic)
+	if err != nil {
+		log.Println("Error getting topic arn: ", err)
+		return "", "", err
+	}
+	if logGroupName == "" {
+		// arn:aws:sns:region:account:topic
+		arnParts := strings.Split(topicArn, ":")
+		logGroupName = fmt.Sprintf("sns/%s/%s/%s/Failure", arnParts[3], arnParts[4], arnParts[5])
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, time.Hour)
+	if err != nil {
+		return "", "", err
+	}
+
+	destinationFailures, err := GetDestinationFailures(clientAuth, logGroupName, startTime, endTime)
+	if err != nil {
+		log.Println("Error getting destination failures: ", err)
+		return "", "", err
+	}
+
+	subscriptions, err := GetSubscriptions(clientAuth, topicArn)
+	if err != nil {
+		log.Println("Error getting subscriptions: ", err)
+		return "", "", err
+	}
+	protocolFailures := groupByProtocol(destinationFailures, subscriptions)
+
+	jsonString, err := json.Marshal(protocolFailures)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", "", err
+	}
+	return string(jsonString), printProtocolFailuresTable(protocolFailures), nil
+}
+
+// GetDestinationFailures counts the failed deliveries in the delivery status log group
+// by destination with a Logs Insights query.
+func GetDestinationFailures(clientAuth *model.Auth, logGroupName string, startTime, endTime *time.Time) (map[string]int64, error) {
+	cloudWatchLogs := awsclient.GetClient(*clientAuth, awsclient.CLOUDWATCH_LOG).(*cloudwatchlogs.CloudWatchLogs)
+
+	queryResult, err := cloudWatchLogs.StartQuery(&cloudwatchlogs.StartQueryInput{
+		LogGroupName: aws.String(logGroupName),
+		StartTime:    aws.Int64(startTime.Unix() * 1000),
+		EndTime:      aws.Int64(endTime.Unix() * 1000),
+		QueryString: aws.String(`fields delivery.destination as destination
+		| filter status = "FAILURE"
+		| stats count(*) as failures by destination`),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start query: %v", err)
+	}
+
+	var output *cloudwatchlogs.GetQueryResultsOutput
+	for {
+		output, err = cloudWatchLogs.GetQueryResults(&cloudwatchlogs.GetQueryResultsInput{
+			QueryId: queryResult.QueryId,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get query results: %v", err)
+		}
+		status := aws.StringValue(output.Status)
+		if status == cloudwatchlogs.QueryStatusComplete {
+			break
+		}
+		if status != cloudwatchlogs.QueryStatusScheduled && status != cloudwatchlogs.QueryStatusRunning {
+			return nil, fmt.Errorf("query ended with status %s", status)
+		}
+		time.Sleep(time.Second)
+	}
+
+	destinationFailures := map[string]int64{}
+	for _, row := range output.Results {
+		var destination string
+		var failures int64
+		for _, field := range row {
+			switch aws.StringValue(field.Field) {
+			case "destination":
+				destination = aws.StringValue(field.Value)
+			case "failures":
+				failures, _ = strconv.ParseInt(aws.StringValue(field.Value), 10, 64)
+			}
+		}
+		destinationFailures[destination] += failures
+	}
+	return destinationFailures, nil
+}
+
+// groupByProtocol sums the failures per protocol, taking the protocol of the subscription
+// with the destination as endpoint or else guessing it from the destination.
+func groupByProtocol(destinationFailures map[string]int64, subscriptions []Subscription) []ProtocolFailures {
+	endpointProtocols := map[string]string{}
+	for _, subscription := range subscriptions {
+		endpointProtocols[subscription.Endpoint] = subscription.Protocol
+	}
+
+	failuresByProtocol := map[string]*ProtocolFailures{}
+	for destination, failures := range destinationFailures {
+		protocol, ok := endpointProtocols[destination]
+		if !ok {
+			protocol = "unknown"
+			for _, d := range destinationProtocols {
+				if strings.HasPrefix(destination, d.prefix) {
+					protocol = d.protocol
+					break
+				}
+			}
+		}
+		if failuresByProtocol[protocol] == nil {
+			failuresByProtocol[protocol] = &ProtocolFailures{Protocol: protocol}
+		}
+		failuresByProtocol[protocol].Failures += failures
+		failuresByProtocol[protocol].Endpoints++
+	}
+
+	protocolFailures := []ProtocolFailures{}
+	for _, failures := range failuresByProtocol {
+		protocolFailures = append(protocolFailures, *failures)
+	}
+	sort.Slice(protocolFailures, func(i, j int) bool {
+		if protocolFailures[i].Failures != protocolFailures[j].Failures {
+			return protocolFailures[i].Failures > protocolFailures[j].Failures
+		}
+		return protocolFailures[i].Protocol < protocolFailures[j].Protocol
+	})
+	return protocolFailures
+}
+
+func printProtocolFailuresTable(protocolFailures []ProtocolFailures) string {
+	var buffer bytes.Buffer
+	if len(protocolFailures) == 0 {
+		buffer.WriteString("No delivery failures found.")
+		return buffer.String()
+	}
+
+	table := tablewriter.NewWriter(&buffer)
+	table.SetHeader([]string{"Protocol", "Failures", "Endpoints"})
+	for _, failures := range protocolFailures {
+		table.Append([]string{
+			failures.Protocol,
+			strconv.FormatInt(failures.Failures, 10),
+			strconv.Itoa(failures.Endpoints),
+		})
+	}
+	table.Render()
+	return buffer.String()
+}
+
+func init() {
+	AwsxSNSProtocolFailuresCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxSNSProtocolFailuresCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxSNSProtocolFailuresCmd.PersistentFlags().String("query", "", "query")
+	AwsxSNSProtocolFailuresCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxSNSProtocolFailuresCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxSNSProtocolFailuresCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxSNSProtocolFailuresCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxSNSProtocolFailuresCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxSNSProtocolFailuresCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxSNSProtocolFailuresCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxSNSProtocolFailuresCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxSNSProtocolFailuresCmd.PersistentFlags().String("TopicName", "", "sns topic name or arn")
+	AwsxSNSProtocolFailuresCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxSNSProtocolFailuresCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxSNSProtocolFailuresCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxSNSProtocolFailuresCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+	AwsxSNSProtocolFailuresCmd.PersistentFlags().String("logGroupName", "", "delivery status failure log group. defaults to sns/<region>/<account>/<topic>/Failure")
+}
@@ -0,0 +1,75 @@
+package SNS
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+// smsSpendSeries has no dimensions, as SNS publishes the SMS metrics for the account
+var smsSpendSeries = []Metric.Series{
+	{Label: "SMSMonthToDateSpentUSD", MetricName: "SMSMonthToDateSpentUSD", Statistic: "Maximum", Dimensions: []*cloudwatch.Dimension{}},
+	{Label: "SMSSuccessRate", MetricName: "SMSSuccessRate", Statistic: "Average", Dimensions: []*cloudwatch.Dimension{}},
+}
+
+var AwsxSNSSMSSpendCmd = &cobra.Command{
+	Use:   "sns_sms_spend_panel",
+	Short: "get SMS spend of the account",
+	Long:  `command to get the month to date SMS spend and SMS success rate. both are published for the account, not per topic`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetSNSSMSSpendPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting sns sms spend: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetSNSSMSSpendPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetSNSTopicPanel(cmd, clientAuth, smsSpendSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxSNSSMSSpendCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxSNSSMSSpendCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxSNSSMSSpendCmd.PersistentFlags().String("query", "", "query")
+	AwsxSNSSMSSpendCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxSNSSMSSpendCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxSNSSMSSpendCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxSNSSMSSpendCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxSNSSMSSpendCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxSNSSMSSpendCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxSNSSMSSpendCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxSNSSMSSpendCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxSNSSMSSpendCmd.PersistentFlags().String("TopicName", "", "sns topic name or arn")
+	AwsxSNSSMSSpendCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxSNSSMSSpendCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxSNSSMSSpendCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxSNSSMSSpendCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,117 @@
+package SNS
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+var AwsxSNSSubscriptionsCmd = &cobra.Command{
+	Use:   "sns_subscriptions_panel",
+	Short: "get the subscriptions of the topic",
+	Long:  `command to get the subscriptions of the topic with their endpoint and whether a dead-letter queue is configured`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, table, err := GetSNSSubscriptionsPanel(cmd, clientAuth)
+			if err != nil {
+				log.Println("Error getting sns subscriptions: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(table)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetSNSSubscriptionsPanel returns the subscriptions of the topic with their endpoint and
+// dead-letter queue.
+func GetSNSSubscriptionsPanel(cmd *cobra.Command, clientAuth *model.Auth) (string, string, error) {
+	topic, err := GetSNSTopicName(cmd)
+	if err != nil {
+		return "", "", err
+	}
+
+	topicArn, err := GetTopicArn(clientAuth, topic)
+	if err != nil {
+		log.Println("Error getting topic arn: ", err)
+		return "", "", err
+	}
+
+	subscriptions, err := GetSubscriptions(clientAuth, topicArn)
+	if err != nil {
+		log.Println("Error getting subscriptions: ", err)
+		return "", "", err
+	}
+
+	jsonString, err := json.Marshal(subscriptions)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", "", err
+	}
+	return string(jsonString), printSubscriptionsTable(subscriptions), nil
+}
+
+func printSubscriptionsTable(subscriptions []Subscription) string {
+	var buffer bytes.Buffer
+	if len(subscriptions) == 0 {
+		buffer.WriteString("No subscriptions found.")
+		return buffer.String()
+	}
+
+	table := tablewriter.NewWriter(&buffer)
+	table.SetHeader([]string{"Protocol", "Endpoint", "Subscription", "Dead-Letter Queue"})
+	for _, subscription := range subscriptions {
+		deadLetterQueue := subscription.DeadLetterQueue
+		if deadLetterQueue == "" {
+			deadLetterQueue = "None"
+		}
+		table.Append([]string{
+			subscription.Protocol,
+			subscription.Endpoint,
+			subscription.SubscriptionArn,
+			deadLetterQueue,
+		})
+	}
+	table.Render()
+	return buffer.String()
+}
+
+func init() {
+	AwsxSNSSubscriptionsCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxSNSSubscriptionsCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxSNSSubscriptionsCmd.PersistentFlags().String("query", "", "query")
+	AwsxSNSSubscriptionsCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxSNSSubscriptionsCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxSNSSubscriptionsCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxSNSSubscriptionsCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxSNSSubscriptionsCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxSNSSubscriptionsCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxSNSSubscriptionsCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxSNSSubscriptionsCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxSNSSubscriptionsCmd.PersistentFlags().String("TopicName", "", "sns topic name or arn")
+	AwsxSNSSubscriptionsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxSNSSubscriptionsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,143 @@
+package SNS
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/cmdb"
+	"github.com/Appkube-awsx/awsx-common/config"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/spf13/cobra"
+)
+
+// Subscription is a subscription of the topic with the dead-letter queue of its redrive
+// policy, if any.
+type Subscription struct {
+	SubscriptionArn string `json:"subscriptionArn"`
+	Protocol        string `json:"protocol"`
+	Endpoint        string `json:"endpoint"`
+	DeadLetterQueue string `json:"deadLetterQueue"`
+}
+
+// GetSNSTopicName returns the topic from the TopicName flag, the CMDB element or the
+// instanceId flag, in that order. A topic ARN is accepted in place of the name.
+func GetSNSTopicName(cmd *cobra.Command) (string, error) {
+	topicName, _ := cmd.PersistentFlags().GetString("TopicName")
+	elementId, _ := cmd.PersistentFlags().GetString("elementId")
+	cmdbApiUrl, _ := cmd.PersistentFlags().GetString("cmdbApiUrl")
+	instanceId, _ := cmd.PersistentFlags().GetString("instanceId")
+
+	if topicName != "" {
+		return topicName, nil
+	}
+
+	if elementId != "" {
+		log.Println("getting cloud-element data from cmdb")
+		apiUrl := cmdbApiUrl
+		if cmdbApiUrl == "" {
+			log.Println("using default cmdb url")
+			apiUrl = config.CmdbUrl
+		}
+		log.Println("cmdb url: " + apiUrl)
+		cmdbData, err := cmdb.GetCloudElementData(apiUrl, elementId)
+		if err != nil {
+			return "", err
+		}
+		instanceId = cmdbData.InstanceId
+	}
+
+	if instanceId == "" {
+		return "", errors.New("topic name not provided. provide TopicName or elementId")
+	}
+	return instanceId, nil
+}
+
+// topicName returns the TopicName dimension value of a topic name or ARN.
+func topicName(topic string) string {
+	return topic[strings.LastIndex(topic, ":")+1:]
+}
+
+func getSNSClient(clientAuth *model.Auth) *sns.SNS {
+	// awsclient has no SNS client type, so the client is built on the assumed role session
+	return sns.New(awsclient.GetSessionWithAssumeRole(*clientAuth))
+}
+
+// GetTopicArn returns the ARN of the topic, looking it up by name unless an ARN is given.
+func GetTopicArn(clientAuth *model.Auth, topic string) (string, error) {
+	if strings.HasPrefix(topic, "arn:") {
+		return topic, nil
+	}
+
+	var topicArn string
+	err := getSNSClient(clientAuth).ListTopicsPages(&sns.ListTopicsInput{}, func(page *sns.ListTopicsOutput, lastPage bool) bool {
+		for _, t := range page.Topics {
+			if topicName(aws.StringValue(t.TopicArn)) == topic {
+				topicArn = aws.StringValue(t.TopicArn)
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return "", err
+	}
+	if topicArn == "" {
+		return "", fmt.Errorf("topic %s not found", topic)
+	}
+	return topicArn, nil
+}
+
+// GetSubscriptions lists the subscriptions of the topic. The redrive policy is read for
+// confirmed subscriptions only, as pending ones have no attributes yet.
+func GetSubscriptions(clientAuth *model.Auth, topicArn string) ([]Subscription, error) {
+	snsClient := getSNSClient(clientAuth)
+
+	subscriptions := []Subscription{}
+	input := &sns.ListSubscriptionsByTopicInput{
+		TopicArn: aws.String(topicArn),
+	}
+	err := snsClient.ListSubscriptionsByTopicPages(input, func(page *sns.ListSubscriptionsByTopicOutput, lastPage bool) bool {
+		for _, s := range page.Subscriptions {
+			subscriptions = append(subscriptions, Subscription{
+				SubscriptionArn: aws.StringValue(s.SubscriptionArn),
+				Protocol:        aws.StringValue(s.Protocol),
+				Endpoint:        aws.StringValue(s.Endpoint),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, subscription := range subscriptions {
+		if !strings.HasPrefix(subscription.SubscriptionArn, "arn:") {
+			continue
+		}
+		output, err := snsClient.GetSubscriptionAttributes(&sns.GetSubscriptionAttributesInput{
+			SubscriptionArn: aws.String(subscription.SubscriptionArn),
+		})
+		if err != nil {
+			log.Printf("Error getting attributes of subscription %s: %v", subscription.SubscriptionArn, err)
+			return nil, err
+		}
+		redrivePolicy := aws.StringValue(output.Attributes["RedrivePolicy"])
+		if redrivePolicy == "" {
+			continue
+		}
+		var policy struct {
+			DeadLetterTargetArn string `json:"deadLetterTargetArn"`
+		}
+		if err := json.Unmarshal([]byte(redrivePolicy), &policy); err != nil {
+			return nil, err
+		}
+		subscriptions[i].DeadLetterQueue = policy.DeadLetterTargetArn
+	}
+	return subscriptions, nil
+}
@@ -0,0 +1,115 @@
+package SQS
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+type DeadLetterQueueDepth struct {
+	DeadLetterQueue string                        `json:"deadLetterQueue"`
+	MaxReceiveCount int64                         `json:"maxReceiveCount"`
+	Metrics         map[string][]Metric.DataPoint `json:"metrics"`
+}
+
+var deadLetterQueueSeries = []Metric.Series{
+	{Label: "ApproximateNumberOfMessagesVisible", MetricName: "ApproximateNumberOfMessagesVisible", Statistic: "Average"},
+}
+
+var AwsxSQSDeadLetterQueueDepthCmd = &cobra.Command{
+	Use:   "sqs_dead_letter_queue_depth_panel",
+	Short: "get the depth of the dead-letter queue",
+	Long:  `command to get the visible messages of the dead-letter queue the queue redrives to`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetSQSDeadLetterQueueDepthPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting sqs dead-letter queue depth: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetSQSDeadLetterQueueDepthPanel returns the messages waiting in the dead-letter queue the
+// queue redrives to, as given by its RedrivePolicy attribute.
+func GetSQSDeadLetterQueueDepthPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	queueName, err := GetSQSQueueName(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	redrivePolicy, deadLetterQueue, err := GetRedrivePolicy(clientAuth, queueName)
+	if err != nil {
+		log.Println("Error getting redrive policy: ", err)
+		return "", nil, err
+	}
+
+	metrics, cloudwatchMetricData, err := Metric.GetMetricData(clientAuth, namespace, period, queueDimensions(deadLetterQueue), deadLetterQueueSeries, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting dead-letter queue metric data: ", err)
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(DeadLetterQueueDepth{
+		DeadLetterQueue: deadLetterQueue,
+		MaxReceiveCount: redrivePolicy.MaxReceiveCount,
+		Metrics:         metrics,
+	})
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func init() {
+	AwsxSQSDeadLetterQueueDepthCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxSQSDeadLetterQueueDepthCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxSQSDeadLetterQueueDepthCmd.PersistentFlags().String("query", "", "query")
+	AwsxSQSDeadLetterQueueDepthCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxSQSDeadLetterQueueDepthCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxSQSDeadLetterQueueDepthCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxSQSDeadLetterQueueDepthCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxSQSDeadLetterQueueDepthCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxSQSDeadLetterQueueDepthCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxSQSDeadLetterQueueDepthCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxSQSDeadLetterQueueDepthCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxSQSDeadLetterQueueDepthCmd.PersistentFlags().String("QueueName", "", "sqs queue name")
+	AwsxSQSDeadLetterQueueDepthCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxSQSDeadLetterQueueDepthCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxSQSDeadLetterQueueDepthCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxSQSDeadLetterQueueDepthCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
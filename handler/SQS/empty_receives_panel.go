@@ -0,0 +1,73 @@
+package SQS
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var emptyReceivesSeries = []Metric.Series{
+	{Label: "NumberOfEmptyReceives", MetricName: "NumberOfEmptyReceives", Statistic: "Sum"},
+}
+
+var AwsxSQSEmptyReceivesCmd = &cobra.Command{
+	Use:   "sqs_empty_receives_panel",
+	Short: "get empty receives of the queue",
+	Long:  `command to get the ReceiveMessage calls that returned no message`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetSQSEmptyReceivesPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting sqs empty receives: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetSQSEmptyReceivesPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetSQSQueuePanel(cmd, clientAuth, emptyReceivesSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxSQSEmptyReceivesCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxSQSEmptyReceivesCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxSQSEmptyReceivesCmd.PersistentFlags().String("query", "", "query")
+	AwsxSQSEmptyReceivesCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxSQSEmptyReceivesCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxSQSEmptyReceivesCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxSQSEmptyReceivesCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxSQSEmptyReceivesCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxSQSEmptyReceivesCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxSQSEmptyReceivesCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxSQSEmptyReceivesCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxSQSEmptyReceivesCmd.PersistentFlags().String("QueueName", "", "sqs queue name")
+	AwsxSQSEmptyReceivesCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxSQSEmptyReceivesCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxSQSEmptyReceivesCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxSQSEmptyReceivesCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
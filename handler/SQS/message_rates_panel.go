@@ -0,0 +1,75 @@
+package SQS
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var messageRatesSeries = []Metric.Series{
+	{Label: "SentPerSecond", Metrics: []Metric.MetricStat{{MetricName: "NumberOfMessagesSent", Statistic: "Sum"}}, Expression: "{0} / PERIOD({0})"},
+	{Label: "ReceivedPerSecond", Metrics: []Metric.MetricStat{{MetricName: "NumberOfMessagesReceived", Statistic: "Sum"}}, Expression: "{0} / PERIOD({0})"},
+	{Label: "DeletedPerSecond", Metrics: []Metric.MetricStat{{MetricName: "NumberOfMessagesDeleted", Statistic: "Sum"}}, Expression: "{0} / PERIOD({0})"},
+}
+
+var AwsxSQSMessageRatesCmd = &cobra.Command{
+	Use:   "sqs_message_rates_panel",
+	Short: "get send, receive and delete rates of the queue",
+	Long:  `command to get the messages sent, received and deleted per second`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetSQSMessageRatesPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting sqs message rates: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetSQSMessageRatesPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetSQSQueuePanel(cmd, clientAuth, messageRatesSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxSQSMessageRatesCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxSQSMessageRatesCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxSQSMessageRatesCmd.PersistentFlags().String("query", "", "query")
+	AwsxSQSMessageRatesCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxSQSMessageRatesCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxSQSMessageRatesCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxSQSMessageRatesCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxSQSMessageRatesCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxSQSMessageRatesCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxSQSMessageRatesCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxSQSMessageRatesCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxSQSMessageRatesCmd.PersistentFlags().String("QueueName", "", "sqs queue name")
+	AwsxSQSMessageRatesCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxSQSMessageRatesCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxSQSMessageRatesCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxSQSMessageRatesCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,74 @@
+package SQS
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var messagesSeries = []Metric.Series{
+	{Label: "ApproximateNumberOfMessagesVisible", MetricName: "ApproximateNumberOfMessagesVisible", Statistic: "Average"},
+	{Label: "ApproximateNumberOfMessagesNotVisible", MetricName: "ApproximateNumberOfMessagesNotVisible", Statistic: "Average"},
+}
+
+var AwsxSQSMessagesCmd = &cobra.Command{
+	Use:   "sqs_messages_panel",
+	Short: "get visible and in flight messages of the queue",
+	Long:  `command to get the visible and not visible (in flight) messages of the queue`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetSQSMessagesPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting sqs messages: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetSQSMessagesPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetSQSQueuePanel(cmd, clientAuth, messagesSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxSQSMessagesCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxSQSMessagesCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxSQSMessagesCmd.PersistentFlags().String("query", "", "query")
+	AwsxSQSMessagesCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxSQSMessagesCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxSQSMessagesCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxSQSMessagesCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxSQSMessagesCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxSQSMessagesCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxSQSMessagesCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxSQSMessagesCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxSQSMessagesCmd.PersistentFlags().String("QueueName", "", "sqs queue name")
+	AwsxSQSMessagesCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxSQSMessagesCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxSQSMessagesCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxSQSMessagesCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
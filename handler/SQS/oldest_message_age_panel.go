@@ -0,0 +1,73 @@
+package SQS
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var oldestMessageAgeSeries = []Metric.Series{
+	{Label: "ApproximateAgeOfOldestMessage", MetricName: "ApproximateAgeOfOldestMessage", Statistic: "Maximum"},
+}
+
+var AwsxSQSOldestMessageAgeCmd = &cobra.Command{
+	Use:   "sqs_oldest_message_age_panel",
+	Short: "get the age of the oldest message in the queue",
+	Long:  `command to get the approximate age of the oldest message in the queue`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetSQSOldestMessageAgePanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting sqs oldest message age: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetSQSOldestMessageAgePanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetSQSQueuePanel(cmd, clientAuth, oldestMessageAgeSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxSQSOldestMessageAgeCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxSQSOldestMessageAgeCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxSQSOldestMessageAgeCmd.PersistentFlags().String("query", "", "query")
+	AwsxSQSOldestMessageAgeCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxSQSOldestMessageAgeCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxSQSOldestMessageAgeCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxSQSOldestMessageAgeCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxSQSOldestMessageAgeCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxSQSOldestMessageAgeCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxSQSOldestMessageAgeCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxSQSOldestMessageAgeCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxSQSOldestMessageAgeCmd.PersistentFlags().String("QueueName", "", "sqs queue name")
+	AwsxSQSOldestMessageAgeCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxSQSOldestMessageAgeCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxSQSOldestMessageAgeCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxSQSOldestMessageAgeCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
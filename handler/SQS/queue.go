@@ -0,0 +1,100 @@
+package SQS
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/cmdb"
+	"github.com/Appkube-awsx/awsx-common/config"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/spf13/cobra"
+)
+
+// RedrivePolicy is the RedrivePolicy queue attribute
+type RedrivePolicy struct {
+	DeadLetterTargetArn string `json:"deadLetterTargetArn"`
+	MaxReceiveCount     int64  `json:"maxReceiveCount"`
+}
+
+// GetSQSQueueName returns the queue from the QueueName flag, the CMDB element or the
+// instanceId flag, in that order.
+func GetSQSQueueName(cmd *cobra.Command) (string, error) {
+	queueName, _ := cmd.PersistentFlags().GetString("QueueName")
+	elementId, _ := cmd.PersistentFlags().GetString("elementId")
+	cmdbApiUrl, _ := cmd.PersistentFlags().GetString("cmdbApiUrl")
+	instanceId, _ := cmd.PersistentFlags().GetString("instanceId")
+
+	if queueName != "" {
+		return queueName, nil
+	}
+
+	if elementId != "" {
+		log.Println("getting cloud-element data from cmdb")
+		apiUrl := cmdbApiUrl
+		if cmdbApiUrl == "" {
+			log.Println("using default cmdb url")
+			apiUrl = config.CmdbUrl
+		}
+		log.Println("cmdb url: " + apiUrl)
+		cmdbData, err := cmdb.GetCloudElementData(apiUrl, elementId)
+		if err != nil {
+			return "", err
+		}
+		instanceId = cmdbData.InstanceId
+	}
+
+	if instanceId == "" {
+		return "", errors.New("queue name not provided. provide QueueName or elementId")
+	}
+	return instanceId, nil
+}
+
+// GetQueueAttributes returns the requested attributes of the queue.
+func GetQueueAttributes(clientAuth *model.Auth, queueName string, attributeNames ...string) (map[string]string, error) {
+	// awsclient has no SQS client type, so the client is built on the assumed role session
+	sqsClient := sqs.New(awsclient.GetSessionWithAssumeRole(*clientAuth))
+
+	queueUrl, err := sqsClient.GetQueueUrl(&sqs.GetQueueUrlInput{
+		QueueName: aws.String(queueName),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := sqsClient.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		QueueUrl:       queueUrl.QueueUrl,
+		AttributeNames: aws.StringSlice(attributeNames),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return aws.StringValueMap(output.Attributes), nil
+}
+
+// GetRedrivePolicy returns the redrive policy of the queue and the name of its dead-letter queue.
+func GetRedrivePolicy(clientAuth *model.Auth, queueName string) (*RedrivePolicy, string, error) {
+	attributes, err := GetQueueAttributes(clientAuth, queueName, sqs.QueueAttributeNameRedrivePolicy)
+	if err != nil {
+		return nil, "", err
+	}
+
+	policy, ok := attributes[sqs.QueueAttributeNameRedrivePolicy]
+	if !ok || policy == "" {
+		return nil, "", fmt.Errorf("queue %s has no redrive policy", queueName)
+	}
+
+	var redrivePolicy RedrivePolicy
+	if err := json.Unmarshal([]byte(policy), &redrivePolicy); err != nil {
+		return nil, "", err
+	}
+
+	// arn:aws:sqs:region:account:queue-name
+	deadLetterQueue := redrivePolicy.DeadLetterTargetArn[strings.LastIndex(redrivePolicy.DeadLetterTargetArn, ":")+1:]
+	return &redrivePolicy, deadLetterQueue, nil
+}
@@ -0,0 +1,48 @@
+package SQS
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+const namespace = "AWS/SQS"
+
+// period is the granularity SQS publishes its metrics at
+const period = 60
+
+// GetSQSQueuePanel resolves the queue and returns the given series of it.
+func GetSQSQueuePanel(cmd *cobra.Command, clientAuth *model.Auth, series []Metric.Series, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	queueName, err := GetSQSQueueName(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result, cloudwatchMetricData, err := Metric.GetMetricData(clientAuth, namespace, period, queueDimensions(queueName), series, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting queue metric data: ", err)
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func queueDimensions(queueName string) []*cloudwatch.Dimension {
+	return Metric.Dimensions("QueueName", queueName)
+}
@@ -0,0 +1,147 @@
+package SQS
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/spf13/cobra"
+)
+
+type TimeToDrain struct {
+	// Backlog is the current number of visible messages
+	Backlog int64 `json:"backlog"`
+	// ConsumeRate is the messages deleted per second over the time range
+	ConsumeRate float64 `json:"consumeRate"`
+	// TimeToDrainSeconds is nil when nothing was consumed, as the queue never drains
+	TimeToDrainSeconds *float64 `json:"timeToDrainSeconds"`
+	TimeToDrain        string   `json:"timeToDrain"`
+}
+
+var consumedSeries = []Metric.Series{
+	{Label: "NumberOfMessagesDeleted", MetricName: "NumberOfMessagesDeleted", Statistic: "Sum"},
+}
+
+var AwsxSQSTimeToDrainCmd = &cobra.Command{
+	Use:   "sqs_time_to_drain_panel",
+	Short: "get the estimated time to drain the queue",
+	Long:  `command to estimate the time to drain the queue backlog at the current consume rate`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetSQSTimeToDrainPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting sqs time to drain: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetSQSTimeToDrainPanel estimates how long the consumers take to drain the current backlog
+// at the rate they deleted messages over the time range. New messages are not accounted for.
+func GetSQSTimeToDrainPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	queueName, err := GetSQSQueueName(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	attributes, err := GetQueueAttributes(clientAuth, queueName, sqs.QueueAttributeNameApproximateNumberOfMessages)
+	if err != nil {
+		log.Println("Error getting queue attributes: ", err)
+		return "", nil, err
+	}
+	backlog, err := strconv.ParseInt(attributes[sqs.QueueAttributeNameApproximateNumberOfMessages], 10, 64)
+	if err != nil {
+		return "", nil, err
+	}
+
+	metrics, cloudwatchMetricData, err := Metric.GetMetricData(clientAuth, namespace, period, queueDimensions(queueName), consumedSeries, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting consumed messages data: ", err)
+		return "", nil, err
+	}
+
+	result := estimateTimeToDrain(backlog, metrics["NumberOfMessagesDeleted"], endTime.Sub(*startTime))
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+// estimateTimeToDrain divides the backlog by the rate the deleted messages were consumed at
+// over the window.
+func estimateTimeToDrain(backlog int64, deleted []Metric.DataPoint, window time.Duration) TimeToDrain {
+	var consumed float64
+	for _, point := range deleted {
+		consumed += point.Value
+	}
+
+	result := TimeToDrain{Backlog: backlog, TimeToDrain: "never"}
+	if seconds := window.Seconds(); seconds > 0 {
+		result.ConsumeRate = consumed / seconds
+	}
+	if backlog == 0 {
+		drain := 0.0
+		result.TimeToDrainSeconds = &drain
+		result.TimeToDrain = "0s"
+	} else if result.ConsumeRate > 0 {
+		drain := float64(backlog) / result.ConsumeRate
+		result.TimeToDrainSeconds = &drain
+		result.TimeToDrain = (time.Duration(drain) * time.Second).String()
+	}
+	return result
+}
+
+func init() {
+	AwsxSQSTimeToDrainCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxSQSTimeToDrainCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxSQSTimeToDrainCmd.PersistentFlags().String("query", "", "query")
+	AwsxSQSTimeToDrainCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxSQSTimeToDrainCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxSQSTimeToDrainCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxSQSTimeToDrainCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxSQSTimeToDrainCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxSQSTimeToDrainCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxSQSTimeToDrainCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxSQSTimeToDrainCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxSQSTimeToDrainCmd.PersistentFlags().String("QueueName", "", "sqs queue name")
+	AwsxSQSTimeToDrainCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxSQSTimeToDrainCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxSQSTimeToDrainCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxSQSTimeToDrainCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
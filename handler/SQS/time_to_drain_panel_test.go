@@ -0,0 +1,71 @@
+package SQS
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+)
+
+var windowStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// deleted returns one NumberOfMessagesDeleted sum per minute per value
+func deleted(sums ...float64) []Metric.DataPoint {
+	points := []Metric.DataPoint{}
+	for i, sum := range sums {
+		points = append(points, Metric.DataPoint{Timestamp: windowStart.Add(time.Duration(i) * time.Minute), Value: sum})
+	}
+	return points
+}
+
+func seconds(value float64) *float64 {
+	return &value
+}
+
+func TestEstimateTimeToDrain(t *testing.T) {
+	tests := []struct {
+		name    string
+		backlog int64
+		deleted []Metric.DataPoint
+		window  time.Duration
+		want    TimeToDrain
+	}{
+		{
+			name:    "empty queue",
+			backlog: 0,
+			deleted: deleted(60),
+			window:  time.Minute,
+			want:    TimeToDrain{Backlog: 0, ConsumeRate: 1, TimeToDrainSeconds: seconds(0), TimeToDrain: "0s"},
+		},
+		{
+			name:    "nothing consumed",
+			backlog: 100,
+			deleted: deleted(0, 0),
+			window:  2 * time.Minute,
+			want:    TimeToDrain{Backlog: 100, TimeToDrain: "never"},
+		},
+		{
+			name:    "rate over the whole window",
+			backlog: 300,
+			deleted: deleted(60, 0, 0, 60, 0),
+			window:  2 * time.Minute,
+			want:    TimeToDrain{Backlog: 300, ConsumeRate: 1, TimeToDrainSeconds: seconds(300), TimeToDrain: "5m0s"},
+		},
+		{
+			name:    "empty window",
+			backlog: 100,
+			deleted: deleted(60),
+			window:  0,
+			want:    TimeToDrain{Backlog: 100, TimeToDrain: "never"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := estimateTimeToDrain(test.backlog, test.deleted, test.window)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("estimateTimeToDrain = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
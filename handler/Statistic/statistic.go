@@ -0,0 +1,78 @@
+package Statistic
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var standardStatistics = map[string]string{
+	"average":     "Average",
+	"sum":         "Sum",
+	"minimum":     "Minimum",
+	"maximum":     "Maximum",
+	"samplecount": "SampleCount",
+}
+
+var (
+	// percentileRegex matches p50, p99.9 and the shorthand trimmed/winsorized means
+	// and counts such as tm90, wm99, tc90 and ts90
+	percentileRegex = regexp.MustCompile(`^(p|tm|wm|tc|ts)(\d{1,2}(\.\d+)?|100)$`)
+	// rangeRegex matches the range forms such as TM(10%:90%) and PR(:300)
+	rangeRegex = regexp.MustCompile(`^(tm|wm|tc|ts|pr)\(([^)]*)\)$`)
+)
+
+// GetStatistics reads the comma separated stat flag, e.g. --stat=p50,p90,p99. It returns
+// nil when the flag is not set so panels keep their default statistic.
+func GetStatistics(cmd *cobra.Command) ([]string, error) {
+	stat, _ := cmd.PersistentFlags().GetString("stat")
+	if strings.TrimSpace(stat) == "" {
+		return nil, nil
+	}
+	return ParseStatistics(stat)
+}
+
+// ParseStatistics validates a comma separated list of CloudWatch statistics and returns
+// them in the form MetricStat.Stat expects. Besides the standard statistics it accepts
+// percentiles (p95), trimmed means (TM90), the other extended statistics and IQM.
+func ParseStatistics(stat string) ([]string, error) {
+	var statistics []string
+	seen := map[string]bool{}
+	for _, part := range strings.Split(stat, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		statistic, err := normalize(part)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[statistic] {
+			seen[statistic] = true
+			statistics = append(statistics, statistic)
+		}
+	}
+	if len(statistics) == 0 {
+		return nil, fmt.Errorf("invalid stat %q", stat)
+	}
+	return statistics, nil
+}
+
+func normalize(statistic string) (string, error) {
+	lower := strings.ToLower(statistic)
+	if standard, ok := standardStatistics[lower]; ok {
+		return standard, nil
+	}
+	if lower == "iqm" {
+		return "IQM", nil
+	}
+	if percentileRegex.MatchString(lower) {
+		return lower, nil
+	}
+	if match := rangeRegex.FindStringSubmatch(lower); match != nil {
+		return strings.ToUpper(match[1]) + "(" + match[2] + ")", nil
+	}
+	return "", fmt.Errorf("unsupported stat %q. use Average, Sum, Minimum, Maximum, SampleCount, pNN, TMNN or IQM", statistic)
+}
@@ -0,0 +1,73 @@
+package StepFunctions
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var executionThrottledSeries = []Metric.Series{
+	{Label: "ExecutionThrottled", MetricName: "ExecutionThrottled", Statistic: "Sum"},
+}
+
+var AwsxStepFunctionsExecutionThrottledCmd = &cobra.Command{
+	Use:   "step_functions_execution_throttled_panel",
+	Short: "get throttled executions of the state machine",
+	Long:  `command to get the state transitions of the state machine that were throttled`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetStepFunctionsExecutionThrottledPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting step functions execution throttled: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetStepFunctionsExecutionThrottledPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetStepFunctionsStateMachinePanel(cmd, clientAuth, executionThrottledSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxStepFunctionsExecutionThrottledCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxStepFunctionsExecutionThrottledCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxStepFunctionsExecutionThrottledCmd.PersistentFlags().String("query", "", "query")
+	AwsxStepFunctionsExecutionThrottledCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxStepFunctionsExecutionThrottledCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxStepFunctionsExecutionThrottledCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxStepFunctionsExecutionThrottledCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxStepFunctionsExecutionThrottledCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxStepFunctionsExecutionThrottledCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxStepFunctionsExecutionThrottledCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxStepFunctionsExecutionThrottledCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxStepFunctionsExecutionThrottledCmd.PersistentFlags().String("StateMachineArn", "", "step functions state machine arn")
+	AwsxStepFunctionsExecutionThrottledCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxStepFunctionsExecutionThrottledCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxStepFunctionsExecutionThrottledCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxStepFunctionsExecutionThrottledCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
@@ -0,0 +1,87 @@
+package StepFunctions
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Statistic"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var defaultExecutionTimeStatistics = []string{"p50", "p90", "p99"}
+
+var AwsxStepFunctionsExecutionTimeCmd = &cobra.Command{
+	Use:   "step_functions_execution_time_panel",
+	Short: "get execution time percentiles of the state machine",
+	Long:  `command to get the execution time percentiles of the state machine`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetStepFunctionsExecutionTimePanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting step functions execution time: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetStepFunctionsExecutionTimePanel returns ExecutionTime for each statistic of the stat flag,
+// p50, p90 and p99 by default.
+func GetStepFunctionsExecutionTimePanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	statistics, err := Statistic.GetStatistics(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+	if statistics == nil {
+		statistics = defaultExecutionTimeStatistics
+	}
+
+	var series []Metric.Series
+	for _, statistic := range statistics {
+		series = append(series, Metric.Series{Label: statistic, MetricName: "ExecutionTime", Statistic: statistic})
+	}
+	return GetStepFunctionsStateMachinePanel(cmd, clientAuth, series, cloudWatchClient)
+}
+
+func init() {
+	AwsxStepFunctionsExecutionTimeCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxStepFunctionsExecutionTimeCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxStepFunctionsExecutionTimeCmd.PersistentFlags().String("query", "", "query")
+	AwsxStepFunctionsExecutionTimeCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxStepFunctionsExecutionTimeCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxStepFunctionsExecutionTimeCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxStepFunctionsExecutionTimeCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxStepFunctionsExecutionTimeCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxStepFunctionsExecutionTimeCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxStepFunctionsExecutionTimeCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxStepFunctionsExecutionTimeCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxStepFunctionsExecutionTimeCmd.PersistentFlags().String("StateMachineArn", "", "step functions state machine arn")
+	AwsxStepFunctionsExecutionTimeCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxStepFunctionsExecutionTimeCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxStepFunctionsExecutionTimeCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxStepFunctionsExecutionTimeCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+	AwsxStepFunctionsExecutionTimeCmd.PersistentFlags().String("stat", "", "comma separated statistics. defaults to p50,p90,p99")
+}
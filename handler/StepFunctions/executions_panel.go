@@ -0,0 +1,77 @@
+package StepFunctions
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+var executionsSeries = []Metric.Series{
+	{Label: "ExecutionsStarted", MetricName: "ExecutionsStarted", Statistic: "Sum"},
+	{Label: "ExecutionsSucceeded", MetricName: "ExecutionsSucceeded", Statistic: "Sum"},
+	{Label: "ExecutionsFailed", MetricName: "ExecutionsFailed", Statistic: "Sum"},
+	{Label: "ExecutionsTimedOut", MetricName: "ExecutionsTimedOut", Statistic: "Sum"},
+	{Label: "ExecutionsAborted", MetricName: "ExecutionsAborted", Statistic: "Sum"},
+}
+
+var AwsxStepFunctionsExecutionsCmd = &cobra.Command{
+	Use:   "step_functions_executions_panel",
+	Short: "get executions of the state machine",
+	Long:  `command to get the started, succeeded, failed, timed out and aborted executions of the state machine`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetStepFunctionsExecutionsPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting step functions executions: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+func GetStepFunctionsExecutionsPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	return GetStepFunctionsStateMachinePanel(cmd, clientAuth, executionsSeries, cloudWatchClient)
+}
+
+func init() {
+	AwsxStepFunctionsExecutionsCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxStepFunctionsExecutionsCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxStepFunctionsExecutionsCmd.PersistentFlags().String("query", "", "query")
+	AwsxStepFunctionsExecutionsCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxStepFunctionsExecutionsCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxStepFunctionsExecutionsCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxStepFunctionsExecutionsCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxStepFunctionsExecutionsCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxStepFunctionsExecutionsCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxStepFunctionsExecutionsCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxStepFunctionsExecutionsCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxStepFunctionsExecutionsCmd.PersistentFlags().String("StateMachineArn", "", "step functions state machine arn")
+	AwsxStepFunctionsExecutionsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxStepFunctionsExecutionsCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxStepFunctionsExecutionsCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxStepFunctionsExecutionsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
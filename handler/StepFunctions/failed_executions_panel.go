@@ -0,0 +1,195 @@
+package StepFunctions
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// defaultTopN is the number of executions returned without the topN flag
+const defaultTopN = 10
+
+// maxCauseLength is where the cause is cut in the table; the json keeps it whole
+const maxCauseLength = 100
+
+type FailedExecution struct {
+	Name         string    `json:"name"`
+	ExecutionArn string    `json:"executionArn"`
+	StartDate    time.Time `json:"startDate"`
+	StopDate     time.Time `json:"stopDate"`
+	// State is the state the execution was in when it failed
+	State string `json:"state"`
+	Error string `json:"error"`
+	Cause string `json:"cause"`
+}
+
+var AwsxStepFunctionsFailedExecutionsCmd = &cobra.Command{
+	Use:   "step_functions_failed_executions_panel",
+	Short: "get recent failed executions of the state machine",
+	Long:  `command to get the recent failed executions of the state machine with the failing state, error and cause`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, table, err := GetStepFunctionsFailedExecutionsPanel(cmd, clientAuth)
+			if err != nil {
+				log.Println("Error getting step functions failed executions: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(table)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetStepFunctionsFailedExecutionsPanel returns the most recent failed executions of the
+// state machine with the state they failed in and the error and cause of the failure.
+func GetStepFunctionsFailedExecutionsPanel(cmd *cobra.Command, clientAuth *model.Auth) (string, string, error) {
+	topNStr, _ := cmd.PersistentFlags().GetString("topN")
+
+	topN := defaultTopN
+	if topNStr != "" {
+		parsedTopN, err := strconv.Atoi(topNStr)
+		if err != nil || parsedTopN < 1 {
+			return "", "", fmt.Errorf("invalid topN %q", topNStr)
+		}
+		topN = parsedTopN
+	}
+
+	stateMachineArn, err := GetStateMachineArn(cmd)
+	if err != nil {
+		return "", "", err
+	}
+
+	failedExecutions, err := GetFailedExecutions(clientAuth, stateMachineArn, topN)
+	if err != nil {
+		log.Println("Error getting failed executions: ", err)
+		return "", "", err
+	}
+
+	jsonString, err := json.Marshal(failedExecutions)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", "", err
+	}
+	return string(jsonString), printFailedExecutionsTable(failedExecutions), nil
+}
+
+// GetFailedExecutions lists the latest failed executions of the state machine, newest
+// first, and reads the end of the history of each to find where and why it failed.
+func GetFailedExecutions(clientAuth *model.Auth, stateMachineArn string, topN int) ([]FailedExecution, error) {
+	sfnClient := getSFNClient(clientAuth)
+
+	output, err := sfnClient.ListExecutions(&sfn.ListExecutionsInput{
+		StateMachineArn: aws.String(stateMachineArn),
+		StatusFilter:    aws.String(sfn.ExecutionStatusFailed),
+		MaxResults:      aws.Int64(int64(topN)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	failedExecutions := []FailedExecution{}
+	for _, execution := range output.Executions {
+		failedExecution := FailedExecution{
+			Name:         aws.StringValue(execution.Name),
+			ExecutionArn: aws.StringValue(execution.ExecutionArn),
+			StartDate:    aws.TimeValue(execution.StartDate),
+			StopDate:     aws.TimeValue(execution.StopDate),
+		}
+
+		input := &sfn.GetExecutionHistoryInput{
+			ExecutionArn: execution.ExecutionArn,
+			ReverseOrder: aws.Bool(true),
+		}
+		err := sfnClient.GetExecutionHistoryPages(input, func(page *sfn.GetExecutionHistoryOutput, lastPage bool) bool {
+			for _, event := range page.Events {
+				if details := event.ExecutionFailedEventDetails; details != nil {
+					failedExecution.Error = aws.StringValue(details.Error)
+					failedExecution.Cause = aws.StringValue(details.Cause)
+				}
+				// reading backwards, the first state entered is the one that failed
+				if details := event.StateEnteredEventDetails; details != nil {
+					failedExecution.State = aws.StringValue(details.Name)
+					return false
+				}
+			}
+			return true
+		})
+		if err != nil {
+			log.Printf("Error getting execution history for %s: %v", failedExecution.Name, err)
+			return nil, err
+		}
+		failedExecutions = append(failedExecutions, failedExecution)
+	}
+	return failedExecutions, nil
+}
+
+func printFailedExecutionsTable(failedExecutions []FailedExecution) string {
+	var buffer bytes.Buffer
+	if len(failedExecutions) == 0 {
+		buffer.WriteString("No failed executions found.")
+		return buffer.String()
+	}
+
+	table := tablewriter.NewWriter(&buffer)
+	table.SetHeader([]string{"Execution", "Stop Date", "State", "Error", "Cause"})
+	for _, execution := range failedExecutions {
+		cause := execution.Cause
+		if len(cause) > maxCauseLength {
+			cause = cause[:maxCauseLength] + "..."
+		}
+		table.Append([]string{
+			execution.Name,
+			execution.StopDate.Format(time.RFC3339),
+			execution.State,
+			execution.Error,
+			cause,
+		})
+	}
+	table.Render()
+	return buffer.String()
+}
+
+func init() {
+	AwsxStepFunctionsFailedExecutionsCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxStepFunctionsFailedExecutionsCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxStepFunctionsFailedExecutionsCmd.PersistentFlags().String("query", "", "query")
+	AwsxStepFunctionsFailedExecutionsCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxStepFunctionsFailedExecutionsCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxStepFunctionsFailedExecutionsCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxStepFunctionsFailedExecutionsCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxStepFunctionsFailedExecutionsCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxStepFunctionsFailedExecutionsCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxStepFunctionsFailedExecutionsCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxStepFunctionsFailedExecutionsCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxStepFunctionsFailedExecutionsCmd.PersistentFlags().String("StateMachineArn", "", "step functions state machine arn")
+	AwsxStepFunctionsFailedExecutionsCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxStepFunctionsFailedExecutionsCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+	AwsxStepFunctionsFailedExecutionsCmd.PersistentFlags().String("topN", "", "number of executions returned. defaults to 10")
+}
@@ -0,0 +1,149 @@
+package StepFunctions
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Appkube-awsx/awsx-common/authenticate"
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/Appkube-awsx/awsx-getelementdetails/handler/Metric"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+)
+
+// integrationFailureMetrics maps the failure metrics of Task resources to the dimension
+// they are published with. None of them carries the state machine.
+var integrationFailureMetrics = map[string]string{
+	"ActivitiesFailed":          "ActivityArn",
+	"ServiceIntegrationsFailed": "ServiceIntegrationResourceArn",
+	"LambdaFunctionsFailed":     "LambdaFunctionArn",
+}
+
+var AwsxStepFunctionsIntegrationFailuresCmd = &cobra.Command{
+	Use:   "step_functions_integration_failures_panel",
+	Short: "get failures of the activities and service integrations of the state machine",
+	Long:  `command to get the failures of every activity, service integration and lambda function called by the state machine`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("running from child command")
+		var authFlag, clientAuth, err = authenticate.AuthenticateCommand(cmd)
+		if err != nil {
+			log.Printf("Error during authentication: %v\n", err)
+			err := cmd.Help()
+			if err != nil {
+				return
+			}
+			return
+		}
+		if authFlag {
+			responseType, _ := cmd.PersistentFlags().GetString("responseType")
+			jsonResp, cloudwatchMetricResp, err := GetStepFunctionsIntegrationFailuresPanel(cmd, clientAuth, nil)
+			if err != nil {
+				log.Println("Error getting step functions integration failures: ", err)
+				return
+			}
+			if responseType == "frame" {
+				fmt.Println(cloudwatchMetricResp)
+			} else {
+				// default case. it prints json
+				fmt.Println(jsonResp)
+			}
+		}
+
+	},
+}
+
+// GetStepFunctionsIntegrationFailuresPanel returns the failures of every activity, service
+// integration and Lambda function the Task states of the state machine call, keyed by the
+// resource ARN. The metrics are account wide, so failures from other state machines calling
+// the same resource are included.
+func GetStepFunctionsIntegrationFailuresPanel(cmd *cobra.Command, clientAuth *model.Auth, cloudWatchClient *cloudwatch.CloudWatch) (string, map[string]*cloudwatch.GetMetricDataOutput, error) {
+	stateMachineArn, err := GetStateMachineArn(cmd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	resources, err := GetTaskResources(clientAuth, stateMachineArn)
+	if err != nil {
+		log.Println("Error getting task resources: ", err)
+		return "", nil, err
+	}
+	resourceKeys := map[string]bool{}
+	for _, resource := range resources {
+		resourceKeys[resourceKey(resource)] = true
+	}
+
+	if cloudWatchClient == nil {
+		cloudWatchClient = awsclient.GetClient(*clientAuth, awsclient.CLOUDWATCH).(*cloudwatch.CloudWatch)
+	}
+
+	var series []Metric.Series
+	for metricName, dimensionName := range integrationFailureMetrics {
+		input := &cloudwatch.ListMetricsInput{
+			Namespace:  aws.String(namespace),
+			MetricName: aws.String(metricName),
+			Dimensions: []*cloudwatch.DimensionFilter{{Name: aws.String(dimensionName)}},
+		}
+		err := cloudWatchClient.ListMetricsPages(input, func(page *cloudwatch.ListMetricsOutput, lastPage bool) bool {
+			for _, metric := range page.Metrics {
+				for _, dimension := range metric.Dimensions {
+					if aws.StringValue(dimension.Name) == dimensionName && resourceKeys[resourceKey(aws.StringValue(dimension.Value))] {
+						series = append(series, Metric.Series{
+							Label:      aws.StringValue(dimension.Value),
+							MetricName: metricName,
+							Statistic:  "Sum",
+							Dimensions: metric.Dimensions,
+						})
+					}
+				}
+			}
+			return true
+		})
+		if err != nil {
+			log.Println("Error listing integration failure metrics: ", err)
+			return "", nil, err
+		}
+	}
+
+	startTime, endTime, err := Metric.ParseTimeRange(cmd, 5*time.Minute)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result, cloudwatchMetricData, err := Metric.GetMetricData(clientAuth, namespace, period, nil, series, startTime, endTime, cloudWatchClient)
+	if err != nil {
+		log.Println("Error in getting integration failure metric data: ", err)
+		return "", nil, err
+	}
+
+	jsonString, err := json.Marshal(result)
+	if err != nil {
+		log.Println("Error in marshalling json in string: ", err)
+		return "", nil, err
+	}
+
+	return string(jsonString), cloudwatchMetricData, nil
+}
+
+func init() {
+	AwsxStepFunctionsIntegrationFailuresCmd.PersistentFlags().String("elementId", "", "element id")
+	AwsxStepFunctionsIntegrationFailuresCmd.PersistentFlags().String("elementType", "", "element type")
+	AwsxStepFunctionsIntegrationFailuresCmd.PersistentFlags().String("query", "", "query")
+	AwsxStepFunctionsIntegrationFailuresCmd.PersistentFlags().String("cmdbApiUrl", "", "cmdb api")
+	AwsxStepFunctionsIntegrationFailuresCmd.PersistentFlags().String("vaultUrl", "", "vault end point")
+	AwsxStepFunctionsIntegrationFailuresCmd.PersistentFlags().String("vaultToken", "", "vault token")
+	AwsxStepFunctionsIntegrationFailuresCmd.PersistentFlags().String("zone", "", "aws region")
+	AwsxStepFunctionsIntegrationFailuresCmd.PersistentFlags().String("accessKey", "", "aws access key")
+	AwsxStepFunctionsIntegrationFailuresCmd.PersistentFlags().String("secretKey", "", "aws secret key")
+	AwsxStepFunctionsIntegrationFailuresCmd.PersistentFlags().String("crossAccountRoleArn", "", "aws cross account role arn")
+	AwsxStepFunctionsIntegrationFailuresCmd.PersistentFlags().String("externalId", "", "aws external id")
+	AwsxStepFunctionsIntegrationFailuresCmd.PersistentFlags().String("StateMachineArn", "", "step functions state machine arn")
+	AwsxStepFunctionsIntegrationFailuresCmd.PersistentFlags().String("instanceId", "", "instance id")
+	AwsxStepFunctionsIntegrationFailuresCmd.PersistentFlags().String("startTime", "", "start time")
+	AwsxStepFunctionsIntegrationFailuresCmd.PersistentFlags().String("endTime", "", "end time")
+	AwsxStepFunctionsIntegrationFailuresCmd.PersistentFlags().String("responseType", "", "response type. json/frame")
+}
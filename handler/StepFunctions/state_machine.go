@@ -0,0 +1,126 @@
+package StepFunctions
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/Appkube-awsx/awsx-common/awsclient"
+	"github.com/Appkube-awsx/awsx-common/cmdb"
+	"github.com/Appkube-awsx/awsx-common/config"
+	"github.com/Appkube-awsx/awsx-common/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/spf13/cobra"
+)
+
+// stateMachineDefinition is the part of an Amazon States Language definition needed to
+// find the resources the Task states call.
+type stateMachineDefinition struct {
+	States map[string]stateDefinition `json:"States"`
+}
+
+type stateDefinition struct {
+	Type          string                   `json:"Type"`
+	Resource      string                   `json:"Resource"`
+	Branches      []stateMachineDefinition `json:"Branches"`
+	Iterator      *stateMachineDefinition  `json:"Iterator"`
+	ItemProcessor *stateMachineDefinition  `json:"ItemProcessor"`
+}
+
+// GetStateMachineArn returns the state machine from the StateMachineArn flag, the CMDB
+// element or the instanceId flag, in that order.
+func GetStateMachineArn(cmd *cobra.Command) (string, error) {
+	stateMachineArn, _ := cmd.PersistentFlags().GetString("StateMachineArn")
+	elementId, _ := cmd.PersistentFlags().GetString("elementId")
+	cmdbApiUrl, _ := cmd.PersistentFlags().GetString("cmdbApiUrl")
+	instanceId, _ := cmd.PersistentFlags().GetString("instanceId")
+
+	if stateMachineArn != "" {
+		return stateMachineArn, nil
+	}
+
+	if elementId != "" {
+		log.Println("getting cloud-element data from cmdb")
+		apiUrl := cmdbApiUrl
+		if cmdbApiUrl == "" {
+			log.Println("using default cmdb url")
+			apiUrl = config.CmdbUrl
+		}
+		log.Println("cmdb url: " + apiUrl)
+		cmdbData, err := cmdb.GetCloudElementData(apiUrl, elementId)
+		if err != nil {
+			return "", err
+		}
+		if cmdbData.Arn != "" {
+			return cmdbData.Arn, nil
+		}
+		instanceId = cmdbData.InstanceId
+	}
+
+	if instanceId == "" {
+		return "", errors.New("state machine arn not provided. provide StateMachineArn or elementId")
+	}
+	return instanceId, nil
+}
+
+func getSFNClient(clientAuth *model.Auth) *sfn.SFN {
+	// awsclient has no Step Functions client type, so the client is built on the assumed role session
+	return sfn.New(awsclient.GetSessionWithAssumeRole(*clientAuth))
+}
+
+// GetTaskResources returns the resources called by the Task states of the state machine,
+// including those nested in Parallel and Map states.
+func GetTaskResources(clientAuth *model.Auth, stateMachineArn string) ([]string, error) {
+	output, err := getSFNClient(clientAuth).DescribeStateMachine(&sfn.DescribeStateMachineInput{
+		StateMachineArn: aws.String(stateMachineArn),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var definition stateMachineDefinition
+	if err := json.Unmarshal([]byte(aws.StringValue(output.Definition)), &definition); err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	var resources []string
+	var walk func(definition stateMachineDefinition)
+	walk = func(definition stateMachineDefinition) {
+		for _, state := range definition.States {
+			if state.Type == "Task" && state.Resource != "" && !seen[state.Resource] {
+				seen[state.Resource] = true
+				resources = append(resources, state.Resource)
+			}
+			for _, branch := range state.Branches {
+				walk(branch)
+			}
+			if state.Iterator != nil {
+				walk(*state.Iterator)
+			}
+			if state.ItemProcessor != nil {
+				walk(*state.ItemProcessor)
+			}
+		}
+	}
+	walk(definition)
+	return resources, nil
+}
+
+// resourceKey reduces a resource ARN to its service and resource, dropping the region,
+// account and integration pattern. Service integrations are written without region and
+// account in definitions (arn:aws:states:::lambda:invoke.waitForTaskToken) but carry
+// both in their metric dimension.
+func resourceKey(arn string) string {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) < 6 {
+		return arn
+	}
+	resource := parts[5]
+	for _, suffix := range []string{".sync:2", ".sync", ".waitForTaskToken"} {
+		resource = strings.TrimSuffix(resource, suffix)
+	}
+	return parts[2] + ":" + resource
+}
@@ -0,0 +1,8 @@
+package specs
+
+import "embed"
+
+// Files holds the <elementType>/*.md spec files.
+//
+//go:embed */*.md
+var Files embed.FS